@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/sumup/sumup-php/codegen/pkg/generator"
+)
+
+func configFlag(destination *string) cli.Flag {
+	return &cli.PathFlag{
+		Name:        "config",
+		Aliases:     []string{"c"},
+		Usage:       "path of a codegen.yaml configuration file",
+		Destination: destination,
+	}
+}
+
+func overlayFlag(destination *cli.StringSlice) cli.Flag {
+	return &cli.StringSliceFlag{
+		Name:        "overlay",
+		Usage:       "path of an OpenAPI Overlay document applied to the specs before generation, repeatable",
+		Destination: destination,
+	}
+}
+
+// readConfig reads the configuration file, if any.
+func readConfig(filename string) (generator.Config, error) {
+	if filename == "" {
+		return generator.Config{}, nil
+	}
+	cfg, err := generator.ReadConfig(filename)
+	if err != nil {
+		return generator.Config{}, fmt.Errorf("load config: %w", err)
+	}
+	return cfg, nil
+}
+
+// loadGenerator reads the OpenAPI specs, merging several files into one
+// document, applies the overlays and loads them into a generator.
+func loadGenerator(specPaths []string, overlays []string, cfg generator.Config) (*generator.Generator, error) {
+	spec, err := generator.ReadSpecs(specPaths...)
+	if err != nil {
+		return nil, err
+	}
+	spec, err = generator.ApplyOverlays(spec, overlays...)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := generator.ParseSpecs(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	g := generator.New(cfg)
+	if err := g.Load(model); err != nil {
+		return nil, fmt.Errorf("load specs: %w", err)
+	}
+	return g, nil
+}
+
+// filterOptions holds the operation filter flags shared by the commands.
+type filterOptions struct {
+	includeTags       cli.StringSlice
+	excludeTags       cli.StringSlice
+	includeOperations cli.StringSlice
+	excludeOperations cli.StringSlice
+	includeBeta       bool
+}
+
+func (f *filterOptions) flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:        "include-tags",
+			Usage:       "only generate the operations of these tags, along with --include-operations",
+			Destination: &f.includeTags,
+		},
+		&cli.StringSliceFlag{
+			Name:        "exclude-tags",
+			Usage:       "skip the operations of these tags",
+			Destination: &f.excludeTags,
+		},
+		&cli.StringSliceFlag{
+			Name:        "include-operations",
+			Usage:       "only generate the operations with these IDs, along with --include-tags",
+			Destination: &f.includeOperations,
+		},
+		&cli.StringSliceFlag{
+			Name:        "exclude-operations",
+			Usage:       "skip the operations with these IDs",
+			Destination: &f.excludeOperations,
+		},
+		&cli.BoolFlag{
+			Name:        "include-beta",
+			Usage:       "generate the operations flagged with x-beta",
+			Destination: &f.includeBeta,
+		},
+	}
+}
+
+// apply overrides the filters of the configuration with the flags set on
+// the command line.
+func (f *filterOptions) apply(c *cli.Context, cfg *generator.Config) {
+	if c.IsSet("include-tags") {
+		cfg.IncludeTags = f.includeTags.Value()
+	}
+	if c.IsSet("exclude-tags") {
+		cfg.ExcludeTags = f.excludeTags.Value()
+	}
+	if c.IsSet("include-operations") {
+		cfg.IncludeOperations = f.includeOperations.Value()
+	}
+	if c.IsSet("exclude-operations") {
+		cfg.ExcludeOperations = f.excludeOperations.Value()
+	}
+	if c.IsSet("include-beta") {
+		cfg.IncludeBeta = f.includeBeta
+	}
+}
+
+// renderOptions holds the flags shaping the generated classes, shared by the
+// commands rendering the SDK.
+type renderOptions struct {
+	readonlyResponses   bool
+	phpVersion          string
+	modelPlacement      string
+	triggerDeprecations bool
+}
+
+func (r *renderOptions) flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:        "readonly-responses",
+			Usage:       "generate response-only classes as readonly classes with with*() methods",
+			Destination: &r.readonlyResponses,
+		},
+		&cli.StringFlag{
+			Name:        "php-version",
+			Usage:       "targeted PHP version, older runtimes get class constants instead of enums and docblock-only unions",
+			Destination: &r.phpVersion,
+			Value:       "8.2",
+		},
+		&cli.StringFlag{
+			Name:        "model-placement",
+			Usage:       `namespaces of the model classes, "types" for SumUp\Types or "tag" for the namespace of the tag using them`,
+			Destination: &r.modelPlacement,
+			Value:       string(generator.ModelPlacementTypes),
+		},
+		&cli.BoolFlag{
+			Name:        "trigger-deprecations",
+			Usage:       "raise E_USER_DEPRECATED when deprecated operations are called or deprecated properties are set",
+			Destination: &r.triggerDeprecations,
+		},
+	}
+}
+
+// apply overrides the rendering options of the configuration with the flags
+// set on the command line, and fills the ones it leaves empty with the
+// defaults of the flags.
+func (r *renderOptions) apply(c *cli.Context, cfg *generator.Config) {
+	if c.IsSet("readonly-responses") {
+		cfg.ReadonlyResponses = r.readonlyResponses
+	}
+	if c.IsSet("php-version") || cfg.PHPVersion == "" {
+		cfg.PHPVersion = r.phpVersion
+	}
+	if c.IsSet("model-placement") || cfg.ModelPlacement == "" {
+		cfg.ModelPlacement = generator.ModelPlacement(r.modelPlacement)
+	}
+	if c.IsSet("trigger-deprecations") {
+		cfg.TriggerDeprecations = r.triggerDeprecations
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/sumup/sumup-php/codegen/pkg/surface"
+)
+
+func Diff() *cli.Command {
+	var format string
+	var failOnBreaking bool
+	return &cli.Command{
+		Name:      "diff",
+		Usage:     "Compare two public API surfaces dumped by the surface command",
+		ArgsUsage: "old.json new.json",
+		Args:      true,
+		Action: func(c *cli.Context) error {
+			if c.Args().Len() != 2 {
+				return fmt.Errorf("expected two arguments, the paths of the old and new surfaces")
+			}
+
+			before, err := surface.Read(c.Args().Get(0))
+			if err != nil {
+				return err
+			}
+			after, err := surface.Read(c.Args().Get(1))
+			if err != nil {
+				return err
+			}
+			changes := surface.Compare(before, after)
+
+			stdout := c.App.Writer
+			if stdout == nil {
+				stdout = os.Stdout
+			}
+			if err := writeChanges(stdout, format, changes); err != nil {
+				return err
+			}
+
+			if failOnBreaking {
+				breaking := 0
+				for _, change := range changes {
+					if change.Breaking {
+						breaking++
+					}
+				}
+				if breaking > 0 {
+					return fmt.Errorf("%d breaking changes", breaking)
+				}
+			}
+			return nil
+		},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "format",
+				Usage:       `output format, "text" for a list of changes, "changelog" for CHANGELOG.md entries or "json"`,
+				Destination: &format,
+				Value:       "text",
+			},
+			&cli.BoolFlag{
+				Name:        "fail-on-breaking",
+				Usage:       "exit with an error when a change is breaking",
+				Destination: &failOnBreaking,
+			},
+		},
+	}
+}
+
+func writeChanges(w io.Writer, format string, changes []surface.Change) error {
+	var output string
+	switch format {
+	case "text":
+		for _, change := range changes {
+			label := "non-breaking"
+			if change.Breaking {
+				label = "breaking"
+			}
+			output += fmt.Sprintf("%-12s  %s\n", label, change.Description)
+		}
+	case "changelog":
+		output = surface.Changelog(changes)
+	case "json":
+		if changes == nil {
+			changes = []surface.Change{}
+		}
+		encoded, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encode changes: %w", err)
+		}
+		output = string(encoded) + "\n"
+	default:
+		return fmt.Errorf("invalid format %q, expected text, changelog or json", format)
+	}
+
+	if _, err := io.WriteString(w, output); err != nil {
+		return fmt.Errorf("write changes: %w", err)
+	}
+	return nil
+}
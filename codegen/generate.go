@@ -1,65 +1,111 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"os"
+	"path/filepath"
+	"strings"
 
-	"github.com/pb33f/libopenapi"
 	"github.com/urfave/cli/v2"
 
+	"github.com/sumup/sumup-php/codegen/pkg/diff"
 	"github.com/sumup/sumup-php/codegen/pkg/generator"
 )
 
 func Generate() *cli.Command {
 	var (
-		out string
+		out                  string
+		configFile           string
+		overlays             cli.StringSlice
+		check                bool
+		reportFile           string
+		strict               bool
+		cleanWithoutManifest bool
+		render               renderOptions
+		filters              filterOptions
 	)
 
 	return &cli.Command{
-		Name:  "generate",
-		Usage: "Generate the PHP SDK",
-		Args:  true,
+		Name:      "generate",
+		Usage:     "Generate the PHP SDK",
+		Args:      true,
+		ArgsUsage: "openapi.json [more specs...]",
 		Action: func(c *cli.Context) error {
 			if !c.Args().Present() {
 				return fmt.Errorf("empty argument, path to openapi specs expected")
 			}
 
-			specPath := c.Args().First()
-
-			if err := os.MkdirAll(out, os.ModePerm); err != nil {
-				return fmt.Errorf("create output directory %q: %w", out, err)
-			}
-
-			spec, err := os.ReadFile(specPath)
+			cfg, err := readConfig(configFile)
 			if err != nil {
-				return fmt.Errorf("read specs: %w", err)
+				return err
 			}
+			// Flags set on the command line take precedence over the file.
+			if c.IsSet("out") || cfg.Out == "" {
+				cfg.Out = out
+			}
+			cfg.CleanWithoutManifest = cleanWithoutManifest
+			render.apply(c, &cfg)
+			filters.apply(c, &cfg)
 
-			doc, err := libopenapi.NewDocument(spec)
-			if err != nil {
-				return fmt.Errorf("load openapi document: %w", err)
+			specs := c.Args().Slice()
+			if legacySpecs, legacyOut, ok := splitLegacyOutput(specs); ok && !c.IsSet("out") {
+				slog.Warn("passing the output directory as the last argument is deprecated, use --out instead", slog.String("out", legacyOut))
+				specs = legacySpecs
+				cfg.Out = legacyOut
 			}
 
-			model, err := doc.BuildV3Model()
+			g, err := loadGenerator(specs, overlays.Value(), cfg)
 			if err != nil {
-				return fmt.Errorf("build openapi v3 model: %w", err)
+				return err
 			}
 
-			g := generator.New(generator.Config{
-				Out: out,
-			})
+			if check {
+				if err := checkGenerated(c, g, cfg.Out); err != nil {
+					return err
+				}
+			} else {
+				// A strict run renders the SDK in memory first, so that
+				// nothing is written when constructs are downgraded.
+				if strict {
+					if _, err := g.Check(); err != nil {
+						return fmt.Errorf("build sdk: %w", err)
+					}
+				}
+				if !strict || len(g.Report().Downgrades) == 0 {
+					if err := os.MkdirAll(cfg.Out, os.ModePerm); err != nil {
+						return fmt.Errorf("create output directory %q: %w", cfg.Out, err)
+					}
 
-			if err := g.Load(&model.Model); err != nil {
-				return fmt.Errorf("load specs: %w", err)
+					if err := g.Build(); err != nil {
+						return fmt.Errorf("build sdk: %w", err)
+					}
+				}
 			}
 
-			if err := g.Build(); err != nil {
-				return fmt.Errorf("build sdk: %w", err)
+			report := g.Report()
+			if reportFile != "" {
+				encoded, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return fmt.Errorf("encode report: %w", err)
+				}
+				if err := writeOutput(reportFile, append(encoded, '\n'), c.App.Writer); err != nil {
+					return err
+				}
 			}
 
+			if strict && len(report.Downgrades) > 0 {
+				for _, downgrade := range report.Downgrades {
+					fmt.Fprintln(c.App.ErrWriter, downgrade)
+				}
+				return fmt.Errorf("%d constructs of the specs were downgraded", len(report.Downgrades))
+			}
 			return nil
 		},
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
+			configFlag(&configFile),
+			overlayFlag(&overlays),
 			&cli.StringFlag{
 				Name:        "out",
 				Aliases:     []string{"o"},
@@ -68,6 +114,61 @@ func Generate() *cli.Command {
 				Destination: &out,
 				Value:       "../src/",
 			},
-		},
+			&cli.PathFlag{
+				Name:        "report",
+				Usage:       "path of a JSON report listing the generated files, classes, enums and services, and the downgraded constructs of the specs",
+				Destination: &reportFile,
+			},
+			&cli.BoolFlag{
+				Name:        "strict",
+				Usage:       "fail when any construct of the specs is downgraded, such as a oneOf generated as mixed",
+				Destination: &strict,
+			},
+			&cli.BoolFlag{
+				Name:        "clean-without-manifest",
+				Usage:       "remove the files of the generator directories the run does not produce when the output has no manifest, hand-written ones included",
+				Destination: &cleanWithoutManifest,
+			},
+			&cli.BoolFlag{
+				Name:        "check",
+				Usage:       "compare the generated SDK with the output directory without writing it, failing with a diff when they differ",
+				Destination: &check,
+			},
+		}, append(render.flags(), filters.flags()...)...),
+	}
+}
+
+// splitLegacyOutput recognizes the former `generate openapi.json out` form,
+// which took the output directory as the last argument: two arguments, the
+// last of which is a directory or a path without the extension of a spec.
+func splitLegacyOutput(args []string) ([]string, string, bool) {
+	if len(args) != 2 {
+		return args, "", false
+	}
+	last := args[1]
+	switch strings.ToLower(filepath.Ext(last)) {
+	case ".json", ".yaml", ".yml":
+		return args, "", false
+	}
+	if info, err := os.Stat(last); err == nil && !info.IsDir() {
+		return args, "", false
+	}
+	return args[:1], last, true
+}
+
+// checkGenerated prints the diff between the output directory and the
+// generated SDK, failing when they differ.
+func checkGenerated(c *cli.Context, g *generator.Generator, out string) error {
+	changes, err := g.Check()
+	if err != nil {
+		return fmt.Errorf("check sdk: %w", err)
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	for _, change := range changes {
+		fmt.Fprint(c.App.Writer, diff.Unified(change.Name, change.Old, change.New))
 	}
+	return fmt.Errorf("%d files of %q are out of date with the specs, regenerate the SDK", len(changes), out)
 }
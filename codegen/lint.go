@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/sumup/sumup-php/codegen/pkg/generator"
+)
+
+func Lint() *cli.Command {
+	var format string
+	var configFile string
+	var overlays cli.StringSlice
+	var filters filterOptions
+	return &cli.Command{
+		Name:      "lint",
+		Usage:     "Check the OpenAPI specs against the conventions the generator relies on",
+		Args:      true,
+		ArgsUsage: "openapi.json [more specs...]",
+		Action: func(c *cli.Context) error {
+			if !c.Args().Present() {
+				return fmt.Errorf("empty argument, path to openapi specs expected")
+			}
+
+			cfg, err := readConfig(configFile)
+			if err != nil {
+				return err
+			}
+			filters.apply(c, &cfg)
+
+			g, err := loadGenerator(c.Args().Slice(), overlays.Value(), cfg)
+			if err != nil {
+				return err
+			}
+			problems := g.Lint()
+
+			stdout := c.App.Writer
+			if stdout == nil {
+				stdout = os.Stdout
+			}
+			if err := writeProblems(stdout, format, problems); err != nil {
+				return err
+			}
+
+			errors := 0
+			for _, problem := range problems {
+				if problem.Severity == generator.SeverityError {
+					errors++
+				}
+			}
+			if errors > 0 {
+				return fmt.Errorf("%d errors in the specs", errors)
+			}
+			return nil
+		},
+		Flags: append([]cli.Flag{
+			configFlag(&configFile),
+			overlayFlag(&overlays),
+			&cli.StringFlag{
+				Name:        "format",
+				Usage:       `output format, "text" for a line per problem or "json"`,
+				Destination: &format,
+				Value:       "text",
+			},
+		}, filters.flags()...),
+	}
+}
+
+func writeProblems(w io.Writer, format string, problems []generator.Problem) error {
+	var output string
+	switch format {
+	case "text":
+		for _, problem := range problems {
+			output += problem.String() + "\n"
+		}
+	case "json":
+		if problems == nil {
+			problems = []generator.Problem{}
+		}
+		encoded, err := json.MarshalIndent(problems, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encode problems: %w", err)
+		}
+		output = string(encoded) + "\n"
+	default:
+		return fmt.Errorf("invalid format %q, expected text or json", format)
+	}
+
+	if _, err := io.WriteString(w, output); err != nil {
+		return fmt.Errorf("write problems: %w", err)
+	}
+	return nil
+}
@@ -29,6 +29,10 @@ func App() *cli.App {
 		Commands: []*cli.Command{
 			Generate(),
 			Samples(),
+			Surface(),
+			Diff(),
+			Lint(),
+			Rehash(),
 		},
 	}
 }
@@ -0,0 +1,81 @@
+package diff
+
+import "testing"
+
+func TestUnified(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		before, after string
+		missing       string
+		want          string
+	}{
+		{
+			name:   "equal",
+			before: "a\nb\n",
+			after:  "a\nb\n",
+			want:   "",
+		},
+		{
+			name:   "separate hunks",
+			before: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			after:  "1\nTWO\n3\n4\n5\n6\n7\n8\n9\n10\n12\n13\n",
+			want: `--- a/file.php
++++ b/file.php
+@@ -1,5 +1,5 @@
+ 1
+-2
++TWO
+ 3
+ 4
+ 5
+@@ -8,5 +8,5 @@
+ 8
+ 9
+ 10
+-11
+ 12
++13
+`,
+		},
+		{
+			name:    "added",
+			after:   "<?php\n",
+			missing: "before",
+			want: `--- /dev/null
++++ b/file.php
+@@ -0,0 +1 @@
++<?php
+`,
+		},
+		{
+			name:    "removed",
+			before:  "<?php\nclass A {}",
+			missing: "after",
+			want: `--- a/file.php
++++ /dev/null
+@@ -1,2 +0,0 @@
+-<?php
+-class A {}
+\ No newline at end of file
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			before, after := []byte(tt.before), []byte(tt.after)
+			switch tt.missing {
+			case "before":
+				before = nil
+			case "after":
+				after = nil
+			}
+			if got := Unified("file.php", before, after); got != tt.want {
+				t.Errorf("Unified() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,8 +2,8 @@ package generator
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
 )
 
 func (g *Generator) writeApiVersion() error {
@@ -16,30 +16,17 @@ func (g *Generator) writeApiVersion() error {
 		return fmt.Errorf("missing specs: API version is empty")
 	}
 
-	filename := filepath.Join(g.cfg.Out, "ApiVersion.php")
-	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
-	if err != nil {
-		return fmt.Errorf("open %q: %w", filename, err)
-	}
-	defer func() {
-		_ = f.Close()
-	}()
-
-	content := fmt.Sprintf(`<?php
-
-// File generated from our OpenAPI spec
-
-namespace SumUp;
-
-class ApiVersion
-{
-    const CURRENT = '%s';
-}
-`, version)
-
-	if _, err := f.WriteString(content); err != nil {
-		return fmt.Errorf("write file %q: %w", filename, err)
-	}
-
-	return nil
+	return g.writePHPFile("ApiVersion.php", &php.File{
+		Comment: "File generated from our OpenAPI spec",
+		Namespaces: []*php.Namespace{{
+			Name: g.namespace,
+			Decls: []php.Decl{&php.Class{
+				Name: "ApiVersion",
+				Constants: []php.Constant{{
+					Name:  "CURRENT",
+					Value: php.String(version),
+				}},
+			}},
+		}},
+	})
 }
@@ -24,6 +24,10 @@ func (g *Generator) collectSchemaUsage() map[string]*schemaUsage {
 
 	for path, pathItem := range g.spec.Paths.PathItems.FromOldest() {
 		for method, op := range pathItem.GetOperations().FromOldest() {
+			if !g.includesOperation(op) {
+				continue
+			}
+
 			opTags := make([]string, 0, len(op.Tags))
 			for _, tag := range op.Tags {
 				opTags = append(opTags, normalizeTagKey(tag))
@@ -56,7 +60,7 @@ func (g *Generator) collectSchemaUsageInResponse(op *v3.Operation, tags []string
 		}
 
 		for _, mediaType := range response.Content.FromOldest() {
-			g.collectSchemaUsageFromSchema(mediaType.Schema, tags, usage, make(map[*base.SchemaProxy]struct{}), "")
+			g.collectSchemaUsageFromSchema(mediaType.Schema, tags, usage, make(map[*base.SchemaProxy]struct{}), "", nil)
 		}
 	}
 }
@@ -67,7 +71,7 @@ func (g *Generator) collectSchemaUsageInParams(op *v3.Operation, tags []string,
 	}
 
 	for _, param := range op.Parameters {
-		g.collectSchemaUsageFromSchema(param.Schema, tags, usage, make(map[*base.SchemaProxy]struct{}), "")
+		g.collectSchemaUsageFromSchema(param.Schema, tags, usage, make(map[*base.SchemaProxy]struct{}), "", nil)
 	}
 }
 
@@ -77,11 +81,14 @@ func (g *Generator) collectSchemaUsageInRequest(op *v3.Operation, tags []string,
 	}
 
 	for _, mediaType := range op.RequestBody.Content.FromOldest() {
-		g.collectSchemaUsageFromSchema(mediaType.Schema, tags, usage, make(map[*base.SchemaProxy]struct{}), "")
+		g.collectSchemaUsageFromSchema(mediaType.Schema, tags, usage, make(map[*base.SchemaProxy]struct{}), "", nil)
 	}
 }
 
-func (g *Generator) collectSchemaUsageFromSchema(schema *base.SchemaProxy, tags []string, usage map[string]*schemaUsage, stack map[*base.SchemaProxy]struct{}, suggestedName string) {
+// collectSchemaUsageFromSchema records the classes generated for the schema
+// and its nested schemas. Inline schemas are named suggestedName, which the
+// owner claims in the model names.
+func (g *Generator) collectSchemaUsageFromSchema(schema *base.SchemaProxy, tags []string, usage map[string]*schemaUsage, stack map[*base.SchemaProxy]struct{}, suggestedName string, owner any) {
 	if schema == nil {
 		return
 	}
@@ -97,46 +104,65 @@ func (g *Generator) collectSchemaUsageFromSchema(schema *base.SchemaProxy, tags
 		return
 	}
 
-	name := g.registerSchemaUsage(schema, suggestedName, tags, usage)
+	// Wrappers generate the class of the schema they wrap, if any.
+	if wrapped := schemaWrapped(schema); wrapped != nil {
+		g.collectSchemaUsageFromSchema(wrapped, tags, usage, stack, suggestedName, owner)
+		return
+	}
+
+	name := g.registerSchemaUsage(schema, suggestedName, owner, tags, usage)
 	parentName := name
 	if parentName == "" {
 		parentName = suggestedName
 	}
+	if ref := schema.GetReference(); ref != "" {
+		owner = ref
+	}
 
 	if spec.Properties != nil {
 		for propName, propSchema := range spec.Properties.FromOldest() {
 			childName := g.inlinePropertyClassName(parentName, propName, propSchema)
-			g.collectSchemaUsageFromSchema(propSchema, tags, usage, stack, childName)
+			g.collectSchemaUsageFromSchema(propSchema, tags, usage, stack, childName, inlineClassOwner{parent: parentName, position: propName})
 		}
 	}
 
 	if hasSchemaType(spec, "array") && spec.Items != nil && spec.Items.A != nil {
 		itemName := g.inlineArrayItemClassName(parentName, spec.Items.A)
-		g.collectSchemaUsageFromSchema(spec.Items.A, tags, usage, stack, itemName)
+		g.collectSchemaUsageFromSchema(spec.Items.A, tags, usage, stack, itemName, inlineClassOwner{parent: parentName, position: "[]"})
+	}
+
+	if values := schemaMapValues(schema); values != nil {
+		valueName := g.inlineMapValueClassName(parentName, values)
+		g.collectSchemaUsageFromSchema(values, tags, usage, stack, valueName, inlineClassOwner{parent: parentName, position: "{}"})
 	}
 
+	// Inline compositions are merged in the class of the schema, with the
+	// same owner.
 	for _, composite := range spec.AllOf {
-		g.collectSchemaUsageFromSchema(composite, tags, usage, stack, parentName)
+		g.collectSchemaUsageFromSchema(composite, tags, usage, stack, parentName, owner)
 	}
 	for _, composite := range spec.AnyOf {
-		g.collectSchemaUsageFromSchema(composite, tags, usage, stack, parentName)
+		g.collectSchemaUsageFromSchema(composite, tags, usage, stack, parentName, owner)
 	}
 	for _, composite := range spec.OneOf {
-		g.collectSchemaUsageFromSchema(composite, tags, usage, stack, parentName)
+		g.collectSchemaUsageFromSchema(composite, tags, usage, stack, parentName, owner)
 	}
 }
 
-func (g *Generator) registerSchemaUsage(schema *base.SchemaProxy, suggestedName string, tags []string, usage map[string]*schemaUsage) string {
+func (g *Generator) registerSchemaUsage(schema *base.SchemaProxy, suggestedName string, owner any, tags []string, usage map[string]*schemaUsage) string {
 	if schema == nil {
 		return ""
 	}
 
 	name := g.classNameForSchema(schema)
 	if name == "" {
-		if suggestedName == "" || !schemaShouldGenerateClass(schema) {
+		if suggestedName == "" || owner == nil || !schemaShouldGenerateClass(schema) {
 			return ""
 		}
-		name = suggestedName
+		if override := schemaClassNameOverride(schema); override != "" {
+			suggestedName = override
+		}
+		name = g.modelNames.claim(owner, suggestedName)
 		g.inlineSchemaNames[schema] = name
 	}
 
@@ -162,7 +188,14 @@ func (g *Generator) assignSchemasToTags(usage map[string]*schemaUsage) (map[stri
 	namespaceBySchema := make(map[string]string)
 
 	for schemaName, info := range usage {
-		targetTag := typesTagKey
+		targetTag := g.schemaTagKey(info)
+
+		// Named maps with typed values become ArrayObject subclasses.
+		if schemaIsMapClass(info.schema) {
+			result[targetTag] = append(result[targetTag], info.schema)
+			namespaceBySchema[schemaName] = g.namespaceForTag(targetTag)
+			continue
+		}
 
 		// Skip schemas that should remain generic maps in PHP.
 		if !schemaShouldGenerateClass(info.schema) {
@@ -190,7 +223,11 @@ func (g *Generator) classNameForSchema(schema *base.SchemaProxy) string {
 	}
 
 	if ref := schema.GetReference(); ref != "" {
-		return schemaClassName(schema)
+		name := g.componentClassName(strings.TrimPrefix(ref, "#/components/schemas/"), schema)
+		if !schemaIsMapClass(schema) && !schemaShouldGenerateClass(schema) {
+			return name
+		}
+		return g.modelNames.claim(ref, name)
 	}
 
 	if name, ok := g.inlineSchemaNames[schema]; ok {
@@ -200,6 +237,37 @@ func (g *Generator) classNameForSchema(schema *base.SchemaProxy) string {
 	return ""
 }
 
+// componentClassName returns the class name of a component schema before
+// collisions are resolved: the name set in Config.SchemaNames, then the
+// x-codegen.class_name extension of the schema, then the schema name.
+func (g *Generator) componentClassName(name string, schema *base.SchemaProxy) string {
+	if override, ok := g.cfg.SchemaNames[name]; ok {
+		return override
+	}
+	if override := schemaClassNameOverride(schema); override != "" {
+		return override
+	}
+	return componentSchemaClassName(name)
+}
+
+// claimComponentClassNames claims the class names of the component schemas
+// in name order, so that they take precedence over the names of inline
+// schemas and do not depend on the order of the operations.
+func (g *Generator) claimComponentClassNames() {
+	if g.spec.Components == nil || g.spec.Components.Schemas == nil {
+		return
+	}
+
+	names := slices.Sorted(g.spec.Components.Schemas.KeysFromOldest())
+	for _, name := range names {
+		schema := g.spec.Components.Schemas.GetOrZero(name)
+		if schema == nil || schemaMapValues(schema) == nil && !schemaShouldGenerateClass(schema) {
+			continue
+		}
+		g.modelNames.claim("#/components/schemas/"+name, g.componentClassName(name, schema))
+	}
+}
+
 func (g *Generator) inlinePropertyClassName(parentName string, propertyName string, schema *base.SchemaProxy) string {
 	if parentName == "" || schema == nil {
 		return ""
@@ -231,3 +299,19 @@ func (g *Generator) inlineArrayItemClassName(parentName string, schema *base.Sch
 
 	return parentName + "Item"
 }
+
+func (g *Generator) inlineMapValueClassName(parentName string, schema *base.SchemaProxy) string {
+	if parentName == "" || schema == nil {
+		return ""
+	}
+
+	if schema.GetReference() != "" {
+		return ""
+	}
+
+	if !schemaShouldGenerateClass(schema) {
+		return ""
+	}
+
+	return parentName + "Value"
+}
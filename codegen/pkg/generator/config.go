@@ -0,0 +1,208 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"go.yaml.in/yaml/v4"
+)
+
+// defaultNamespace is the root namespace when Config.Namespace is empty.
+const defaultNamespace = "SumUp"
+
+// Layout names the namespaces below the root namespace. Each name is also
+// the directory the namespace is generated in.
+type Layout struct {
+	// Types holds the models with ModelPlacementTypes. Defaults to "Types".
+	Types string `yaml:"types"`
+
+	// Shared holds the models used by several tags with
+	// ModelPlacementTag. Defaults to "Shared".
+	Shared string `yaml:"shared"`
+
+	// Services holds the service classes along with their request and
+	// query parameter classes. Defaults to "Services".
+	Services string `yaml:"services"`
+}
+
+var defaultLayout = Layout{
+	Types:    "Types",
+	Shared:   "Shared",
+	Services: "Services",
+}
+
+// withDefaults fills the empty names of the layout with the default ones.
+func (l Layout) withDefaults() Layout {
+	if l.Types == "" {
+		l.Types = defaultLayout.Types
+	}
+	if l.Shared == "" {
+		l.Shared = defaultLayout.Shared
+	}
+	if l.Services == "" {
+		l.Services = defaultLayout.Services
+	}
+	return l
+}
+
+var phpIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// validateNamespace checks that namespace is a qualified PHP name such as
+// `Acme\SumUp`, without leading or trailing backslashes.
+func validateNamespace(namespace string) error {
+	for _, segment := range strings.Split(namespace, `\`) {
+		if !phpIdentifierPattern.MatchString(segment) {
+			return fmt.Errorf("invalid namespace %q", namespace)
+		}
+	}
+	return nil
+}
+
+// phpScalarTypes are the PHP types a format can be mapped to.
+var phpScalarTypes = []string{"string", "int", "float", "bool"}
+
+// validateConfig checks the names and mappings of the configuration, which
+// end up in the generated code as is.
+func validateConfig(cfg Config) error {
+	if cfg.Namespace != "" {
+		if err := validateNamespace(cfg.Namespace); err != nil {
+			return err
+		}
+	}
+	for _, name := range []string{cfg.Layout.Types, cfg.Layout.Shared, cfg.Layout.Services} {
+		if name != "" && !phpIdentifierPattern.MatchString(name) {
+			return fmt.Errorf("invalid layout namespace %q", name)
+		}
+	}
+	for format, phpType := range cfg.TypeMappings {
+		if !slices.Contains(phpScalarTypes, phpType) {
+			return fmt.Errorf("invalid type mapping of format %q to %q, expected one of %s", format, phpType, strings.Join(phpScalarTypes, ", "))
+		}
+	}
+	for schema, className := range cfg.SchemaNames {
+		if !phpIdentifierPattern.MatchString(className) {
+			return fmt.Errorf("invalid class name %q for schema %q", className, schema)
+		}
+	}
+	for operationID, methodName := range cfg.OperationNames {
+		if !phpIdentifierPattern.MatchString(methodName) {
+			return fmt.Errorf("invalid method name %q for operation %q", methodName, operationID)
+		}
+	}
+	return nil
+}
+
+// configFile is the layout of a codegen.yaml file.
+type configFile struct {
+	Out               string            `yaml:"out"`
+	Namespace         string            `yaml:"namespace"`
+	Layout            Layout            `yaml:"layout"`
+	TypeMappings      map[string]string `yaml:"type_mappings"`
+	SchemaNames       map[string]string `yaml:"schema_names"`
+	OperationNames    map[string]string `yaml:"operation_names"`
+	IncludeTags       []string          `yaml:"include_tags"`
+	ExcludeTags       []string          `yaml:"exclude_tags"`
+	IncludeOperations []string          `yaml:"include_operations"`
+	ExcludeOperations []string          `yaml:"exclude_operations"`
+	Features          struct {
+		ReadonlyResponses   bool           `yaml:"readonly_responses"`
+		PHPVersion          string         `yaml:"php_version"`
+		ModelPlacement      ModelPlacement `yaml:"model_placement"`
+		IncludeBeta         bool           `yaml:"include_beta"`
+		TriggerDeprecations bool           `yaml:"trigger_deprecations"`
+	} `yaml:"features"`
+}
+
+// ReadConfig reads a codegen.yaml configuration file. A relative output
+// directory is resolved against the directory of the file. Unknown keys are
+// rejected so that typos do not go unnoticed.
+func ReadConfig(filename string) (Config, error) {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return Config{}, fmt.Errorf("read config: %w", err)
+	}
+
+	var file configFile
+	decoder := yaml.NewDecoder(bytes.NewReader(contents))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return Config{}, fmt.Errorf("decode config %q: %w", filename, err)
+	}
+
+	cfg := Config{
+		Out:                 file.Out,
+		ReadonlyResponses:   file.Features.ReadonlyResponses,
+		PHPVersion:          file.Features.PHPVersion,
+		ModelPlacement:      file.Features.ModelPlacement,
+		Namespace:           strings.Trim(file.Namespace, `\`),
+		Layout:              file.Layout,
+		TypeMappings:        file.TypeMappings,
+		SchemaNames:         file.SchemaNames,
+		OperationNames:      file.OperationNames,
+		IncludeTags:         file.IncludeTags,
+		ExcludeTags:         file.ExcludeTags,
+		IncludeOperations:   file.IncludeOperations,
+		ExcludeOperations:   file.ExcludeOperations,
+		IncludeBeta:         file.Features.IncludeBeta,
+		TriggerDeprecations: file.Features.TriggerDeprecations,
+	}
+	if cfg.Out != "" && !filepath.IsAbs(cfg.Out) {
+		cfg.Out = filepath.Join(filepath.Dir(filename), cfg.Out)
+	}
+	if err := validateConfig(cfg); err != nil {
+		return Config{}, fmt.Errorf("invalid config %q: %w", filename, err)
+	}
+
+	return cfg, nil
+}
+
+// subNamespace returns the namespace name below the root namespace.
+func (g *Generator) subNamespace(name string) string {
+	return g.namespace + `\` + name
+}
+
+func (g *Generator) typesNamespace() string {
+	return g.subNamespace(g.layout.Types)
+}
+
+func (g *Generator) sharedNamespace() string {
+	return g.subNamespace(g.layout.Shared)
+}
+
+func (g *Generator) servicesNamespace() string {
+	return g.subNamespace(g.layout.Services)
+}
+
+// runtimeName returns the fully qualified name of a class below the root
+// namespace, such as `HttpClient\RequestOptions`.
+func (g *Generator) runtimeName(name string) string {
+	return `\` + g.subNamespace(name)
+}
+
+// serviceName returns the fully qualified name of a class of the services
+// namespace.
+func (g *Generator) serviceName(name string) string {
+	return `\` + g.servicesNamespace() + `\` + name
+}
+
+// mappedType returns the PHP type configured for the format of a scalar
+// schema, if any.
+func (g *Generator) mappedType(spec *base.Schema) string {
+	if spec == nil || schemaFormat(spec) == "" {
+		return ""
+	}
+	for _, typ := range []string{"string", "integer", "number"} {
+		if hasSchemaType(spec, typ) {
+			return g.cfg.TypeMappings[schemaFormat(spec)]
+		}
+	}
+	return ""
+}
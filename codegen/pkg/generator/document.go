@@ -0,0 +1,301 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/bundler"
+	"github.com/pb33f/libopenapi/datamodel"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/overlay"
+	"go.yaml.in/yaml/v4"
+)
+
+// DocumentConfiguration returns the libopenapi configuration the specs are
+// parsed with. A $ref with sibling keywords stays a reference to its schema,
+// as in OpenAPI 3.0, rather than becoming an allOf of the siblings and the
+// reference, so that a 3.0 document and its 3.1 upgrade generate the same SDK.
+func DocumentConfiguration() *datamodel.DocumentConfiguration {
+	cfg := datamodel.NewDocumentConfiguration()
+	cfg.TransformSiblingRefs = false
+	return cfg
+}
+
+// ReadSpecs reads the OpenAPI documents at the paths, in YAML or JSON, into a
+// single document.
+//
+// References to other files are resolved relative to the document containing
+// them, and the schemas, parameters and responses they point to are added to
+// the components of the document. A document without such references is
+// returned as is, so that the locations reported in the specs stay accurate.
+//
+// The paths, webhooks, tags and components of the following documents are
+// merged into the first one. Entries defined by several documents must be
+// identical, tags are merged by name. The other fields, such as info and
+// servers, are taken from the first document.
+func ReadSpecs(paths ...string) ([]byte, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no specs to read")
+	}
+
+	documents := make([][]byte, 0, len(paths))
+	for _, path := range paths {
+		spec, err := readSpec(path)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, spec)
+	}
+	if len(documents) == 1 {
+		return documents[0], nil
+	}
+
+	var merged yaml.Node
+	if err := yaml.Unmarshal(documents[0], &merged); err != nil {
+		return nil, fmt.Errorf("parse specs %s: %w", paths[0], err)
+	}
+	for idx, document := range documents[1:] {
+		var other yaml.Node
+		if err := yaml.Unmarshal(document, &other); err != nil {
+			return nil, fmt.Errorf("parse specs %s: %w", paths[idx+1], err)
+		}
+		if err := mergeSpecs(documentRoot(&merged), documentRoot(&other)); err != nil {
+			return nil, fmt.Errorf("merge specs %s: %w", paths[idx+1], err)
+		}
+	}
+
+	spec, err := yaml.Marshal(&merged)
+	if err != nil {
+		return nil, fmt.Errorf("encode merged specs: %w", err)
+	}
+	return spec, nil
+}
+
+// ApplyOverlays applies the actions of the OpenAPI Overlay documents at the
+// paths to the specs, one document after the other. Actions whose target
+// matches nothing are skipped with a warning.
+func ApplyOverlays(spec []byte, paths ...string) ([]byte, error) {
+	for _, path := range paths {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read overlay: %w", err)
+		}
+		ov, err := libopenapi.NewOverlayDocument(contents)
+		if err != nil {
+			return nil, fmt.Errorf("parse overlay %s: %w", path, err)
+		}
+
+		result, err := overlay.Apply(spec, ov)
+		if err != nil {
+			return nil, fmt.Errorf("apply overlay %s: %w", path, err)
+		}
+		for _, warning := range result.Warnings {
+			slog.Warn("overlay action skipped",
+				slog.String("overlay", path),
+				slog.String("target", warning.Target),
+				slog.String("reason", warning.Message),
+			)
+		}
+		spec = result.Bytes
+	}
+	return spec, nil
+}
+
+// ParseSpecs builds the model of an OpenAPI document, such as one returned by
+// ReadSpecs or ApplyOverlays.
+func ParseSpecs(spec []byte) (*v3.Document, error) {
+	document, err := libopenapi.NewDocumentWithConfiguration(spec, DocumentConfiguration())
+	if err != nil {
+		return nil, fmt.Errorf("load openapi document: %w", err)
+	}
+
+	model, err := document.BuildV3Model()
+	if err != nil {
+		return nil, fmt.Errorf("build openapi v3 model: %w", err)
+	}
+	return &model.Model, nil
+}
+
+// readSpec reads an OpenAPI document, bundling the files it references.
+func readSpec(path string) ([]byte, error) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return nil, fmt.Errorf("read specs: %s is a directory, use --out to set the output directory", path)
+	}
+	spec, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read specs: %w", err)
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(spec, &root); err != nil {
+		return nil, fmt.Errorf("parse specs %s: %w", path, err)
+	}
+	if !hasFileReferences(&root) {
+		return spec, nil
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("resolve specs path: %w", err)
+	}
+	cfg := DocumentConfiguration()
+	cfg.BasePath = filepath.Dir(abs)
+	cfg.SpecFilePath = abs
+	cfg.AllowFileReferences = true
+	bundled, err := bundler.BundleBytesComposed(spec, cfg, nil)
+	if err != nil {
+		return nil, fmt.Errorf("resolve references of %s: %w", path, err)
+	}
+	return bundled, nil
+}
+
+// hasFileReferences reports whether the node references another file.
+func hasFileReferences(node *yaml.Node) bool {
+	if node.Kind == yaml.MappingNode {
+		for idx := 0; idx+1 < len(node.Content); idx += 2 {
+			key, value := node.Content[idx], node.Content[idx+1]
+			if key.Value == "$ref" && value.Kind == yaml.ScalarNode && !strings.HasPrefix(value.Value, "#") {
+				return true
+			}
+		}
+	}
+	for _, child := range node.Content {
+		if hasFileReferences(child) {
+			return true
+		}
+	}
+	return false
+}
+
+func documentRoot(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		return node.Content[0]
+	}
+	return node
+}
+
+// mergeSpecs merges the paths, webhooks, tags and components of a document
+// into another.
+func mergeSpecs(dst, src *yaml.Node) error {
+	if dst.Kind != yaml.MappingNode || src.Kind != yaml.MappingNode {
+		return fmt.Errorf("specs are not objects")
+	}
+
+	for idx := 0; idx+1 < len(src.Content); idx += 2 {
+		key, value := src.Content[idx].Value, src.Content[idx+1]
+		existing := mappingValue(dst, key)
+		if existing == nil {
+			dst.Content = append(dst.Content, src.Content[idx], value)
+			continue
+		}
+
+		var err error
+		switch key {
+		case "paths", "webhooks", "components":
+			// Path items merge their operations, components their entries.
+			err = mergeEntries(existing, value, "/"+key, 2)
+		case "tags":
+			err = mergeTags(existing, value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeEntries adds the entries of the src mapping missing from dst, merging
+// the entries of both depth levels further down. Other entries defined in
+// both must be identical.
+func mergeEntries(dst, src *yaml.Node, pointer string, depth int) error {
+	if dst.Kind != yaml.MappingNode || src.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s is not an object", pointer)
+	}
+
+	for idx := 0; idx+1 < len(src.Content); idx += 2 {
+		key, value := src.Content[idx].Value, src.Content[idx+1]
+		entryPointer := pointer + jsonPointer(key)
+		existing := mappingValue(dst, key)
+		switch {
+		case existing == nil:
+			dst.Content = append(dst.Content, src.Content[idx], value)
+		case depth > 1:
+			if err := mergeEntries(existing, value, entryPointer, depth-1); err != nil {
+				return err
+			}
+		default:
+			same, err := sameNodes(existing, value)
+			if err != nil {
+				return err
+			}
+			if !same {
+				return fmt.Errorf("%s is already defined differently", entryPointer)
+			}
+		}
+	}
+	return nil
+}
+
+// mergeTags adds the tags of src missing from dst, by name.
+func mergeTags(dst, src *yaml.Node) error {
+	if dst.Kind != yaml.SequenceNode || src.Kind != yaml.SequenceNode {
+		return fmt.Errorf("/tags is not an array")
+	}
+
+	names := make(map[string]struct{}, len(dst.Content))
+	for _, tag := range dst.Content {
+		if name := mappingValue(tag, "name"); name != nil {
+			names[name.Value] = struct{}{}
+		}
+	}
+	for _, tag := range src.Content {
+		name := mappingValue(tag, "name")
+		if name == nil {
+			continue
+		}
+		if _, ok := names[name.Value]; ok {
+			continue
+		}
+		names[name.Value] = struct{}{}
+		dst.Content = append(dst.Content, tag)
+	}
+	return nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for idx := 0; idx+1 < len(node.Content); idx += 2 {
+		if node.Content[idx].Value == key {
+			return node.Content[idx+1]
+		}
+	}
+	return nil
+}
+
+// sameNodes reports whether two nodes hold the same value, whatever their
+// formatting.
+func sameNodes(a, b *yaml.Node) (bool, error) {
+	var decodedA, decodedB any
+	if err := a.Decode(&decodedA); err != nil {
+		return false, err
+	}
+	if err := b.Decode(&decodedB); err != nil {
+		return false, err
+	}
+	encodedA, err := yaml.Marshal(decodedA)
+	if err != nil {
+		return false, err
+	}
+	encodedB, err := yaml.Marshal(decodedB)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(encodedA, encodedB), nil
+}
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"slices"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sumup/sumup-php/codegen/pkg/extension"
+)
+
+// includesOperation reports whether an operation passes the filters of the
+// configuration. Schemas are only collected from included operations, so
+// that the models and enums of filtered out operations are not generated
+// either.
+//
+// Exclusions take precedence. When include lists are given, an operation
+// must match one of its tags or its ID. Beta operations, or operations of a
+// beta tag, are only included with Config.IncludeBeta.
+func (g *Generator) includesOperation(op *v3.Operation) bool {
+	if op == nil {
+		return false
+	}
+	if !g.cfg.IncludeBeta && g.isBetaOperation(op) {
+		return false
+	}
+	if slices.Contains(g.cfg.ExcludeOperations, op.OperationId) {
+		return false
+	}
+	for _, tag := range op.Tags {
+		if containsTagKey(g.cfg.ExcludeTags, normalizeTagKey(tag)) {
+			return false
+		}
+	}
+
+	if len(g.cfg.IncludeTags) == 0 && len(g.cfg.IncludeOperations) == 0 {
+		return true
+	}
+	if slices.Contains(g.cfg.IncludeOperations, op.OperationId) {
+		return true
+	}
+	return slices.ContainsFunc(op.Tags, func(tag string) bool {
+		return containsTagKey(g.cfg.IncludeTags, normalizeTagKey(tag))
+	})
+}
+
+// isBetaOperation reports whether the operation, or one of its tags, is
+// flagged with x-beta.
+func (g *Generator) isBetaOperation(op *v3.Operation) bool {
+	if extension.GetOrDefault(op.Extensions, "x-beta", false) {
+		return true
+	}
+	for _, tag := range op.Tags {
+		if spec, ok := g.tagLookup[normalizeTagKey(tag)]; ok && spec != nil && extension.GetOrDefault(spec.Extensions, "x-beta", false) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTagKey(tags []string, key string) bool {
+	return slices.ContainsFunc(tags, func(tag string) bool {
+		return normalizeTagKey(tag) == key
+	})
+}
@@ -1,38 +1,123 @@
 package generator
 
 import (
-	"bytes"
 	"fmt"
 	"log/slog"
 	"maps"
-	"os"
+	"path"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
 )
 
 const (
-	sharedTagKey         = "__shared"
-	sharedTagDisplayName = "Shared"
-	sharedTagNamespace   = "SumUp\\Shared"
-	typesTagKey          = "__types"
-	typesTagDisplayName  = "Types"
-	typesNamespace       = "SumUp\\Types"
+	sharedTagKey = "__shared"
+	typesTagKey  = "__types"
 )
 
 // Config defines generator options.
 type Config struct {
 	// Out is the output directory.
 	Out string
+
+	// Output stores the generated files instead of Out when set, such as a
+	// MemoryOutput rendering the SDK in memory.
+	Output Output
+
+	// ReadonlyResponses generates response-only classes as readonly classes
+	// with with*() methods returning modified copies.
+	ReadonlyResponses bool
+
+	// PHPVersion is the targeted PHP runtime as "major.minor", such as
+	// "8.0". Enums, union types and readonly classes are rendered with
+	// older constructs on runtimes lacking them. Defaults to 8.2.
+	PHPVersion string
+
+	// ModelPlacement selects the namespaces of the model classes. Defaults
+	// to ModelPlacementTypes.
+	ModelPlacement ModelPlacement
+
+	// Namespace is the root namespace of the generated code, such as
+	// `Acme\Vendor\SumUp` for a vendor-prefixed build. The hand-written
+	// runtime classes are expected in the same namespace. Defaults to
+	// "SumUp".
+	Namespace string
+
+	// Layout names the namespaces below Namespace.
+	Layout Layout
+
+	// TypeMappings maps the format of string, integer and number schemas,
+	// such as "int64" or "decimal", to the scalar PHP type they are
+	// generated as. Payload values are cast to the mapped type and sent back
+	// as is.
+	TypeMappings map[string]string
+
+	// SchemaNames overrides the class names of component schemas, keyed by
+	// schema name.
+	SchemaNames map[string]string
+
+	// OperationNames overrides the method names of operations, keyed by
+	// operation ID.
+	OperationNames map[string]string
+
+	// IncludeTags restricts the generated services to the operations of
+	// these tags, along with the operations of IncludeOperations. All
+	// operations are included when both are empty.
+	IncludeTags []string
+
+	// ExcludeTags skips the operations of these tags.
+	ExcludeTags []string
+
+	// IncludeOperations restricts the generated services to these operation
+	// IDs, along with the operations of IncludeTags.
+	IncludeOperations []string
+
+	// ExcludeOperations skips the operations with these IDs.
+	ExcludeOperations []string
+
+	// IncludeBeta generates the operations flagged with x-beta, either
+	// directly or through their tag, which are skipped otherwise.
+	IncludeBeta bool
+
+	// TriggerDeprecations raises an E_USER_DEPRECATED error when a
+	// deprecated operation is called, or a deprecated property is set
+	// through a constructor, a setter or a wither.
+	TriggerDeprecations bool
+
+	// CleanWithoutManifest removes, from an output without manifest, the
+	// files of the generator directories that the run does not produce,
+	// hand-written ones included. Without it, only the files listed in the
+	// manifest of the previous run are ever removed.
+	CleanWithoutManifest bool
+
+	// Hooks extend the generation, in order. They are set from Go only, not
+	// from the configuration file.
+	Hooks []Hook
 }
 
 // Generator orchestrates the SDK generation.
 type Generator struct {
 	cfg Config
 
+	// php is the targeted PHP runtime parsed from Config.PHPVersion.
+	php phpVersion
+
+	// placement is the model placement parsed from Config.ModelPlacement.
+	placement ModelPlacement
+
+	// namespace is the root namespace from Config.Namespace.
+	namespace string
+
+	// layout names the namespaces below the root namespace.
+	layout Layout
+
 	spec *v3.Document
 
 	tagLookup map[string]*base.Tag
@@ -40,6 +125,14 @@ type Generator struct {
 	// inlineSchemaNames tracks deterministic generated names for inline object schemas.
 	inlineSchemaNames map[*base.SchemaProxy]string
 
+	// modelNames hands out the names of the model classes and enums, which
+	// are unique across the model namespaces.
+	modelNames *nameRegistry
+
+	// serviceNames hands out the names of the classes of the services
+	// namespace.
+	serviceNames *nameRegistry
+
 	// schemasByTag maps normalized tag names to schemas they own.
 	schemasByTag map[string][]*base.SchemaProxy
 
@@ -56,6 +149,56 @@ type Generator struct {
 
 	// requestClassNames tracks schema classes used as OpenAPI request bodies.
 	requestClassNames map[string]struct{}
+
+	// interfaceSchemaNames tracks schemas used as one of several allOf parents,
+	// which are rendered with a marker interface.
+	interfaceSchemaNames map[string]struct{}
+
+	// mapClassNames tracks generated map classes, which extend ArrayObject.
+	mapClassNames map[string]struct{}
+
+	// explicitFieldClassNames tracks request classes sent with PATCH or PUT,
+	// which record explicitly set fields so that null can clear a value.
+	explicitFieldClassNames map[string]struct{}
+
+	// readonlyClassNames tracks response-only classes generated as readonly
+	// classes when Config.ReadonlyResponses is set.
+	readonlyClassNames map[string]struct{}
+
+	// out stores the generated files, Config.Output or Config.Out.
+	out Output
+
+	// generated lists the files written by Build.
+	generated manifest
+
+	// tagDirs lists the directories of the tag files written by Build.
+	tagDirs []string
+
+	// hookFiles tracks the files written by the hooks, which the generator
+	// must not overwrite.
+	hookFiles map[string]struct{}
+
+	// surface collects the public API of the written files while Surface
+	// runs Build.
+	surface *surfaceCollector
+
+	// emitted lists the declarations written by Build.
+	emitted Report
+
+	// downgrades records the constructs of the specs not rendered
+	// faithfully, while loading the specs and building the SDK.
+	downgrades map[Downgrade]struct{}
+
+	// nodePointers maps the YAML nodes of the specs to their JSON pointer,
+	// indexed on the first downgraded schema.
+	nodePointers map[*yaml.Node]string
+
+	// classSchemas maps the classes built from a schema to it, for the hooks.
+	classSchemas map[*php.Class]*base.SchemaProxy
+
+	// classOperations maps the services to their operations, keyed by method
+	// name, for the hooks.
+	classOperations map[*php.Class]map[string]*operation
 }
 
 type enumDefinition struct {
@@ -67,10 +210,26 @@ type enumDefinition struct {
 
 // New creates a new Generator instance.
 func New(cfg Config) *Generator {
+	out := cfg.Output
+	if out == nil {
+		out = DirOutput{Dir: cfg.Out}
+	}
 	return &Generator{
-		cfg:               cfg,
-		inlineSchemaNames: make(map[*base.SchemaProxy]string),
-		requestClassNames: make(map[string]struct{}),
+		cfg:                     cfg,
+		out:                     out,
+		php:                     defaultPHPVersion,
+		placement:               ModelPlacementTypes,
+		namespace:               defaultNamespace,
+		layout:                  defaultLayout,
+		inlineSchemaNames:       make(map[*base.SchemaProxy]string),
+		modelNames:              newNameRegistry("Model"),
+		serviceNames:            newNameRegistry("Service"),
+		requestClassNames:       make(map[string]struct{}),
+		explicitFieldClassNames: make(map[string]struct{}),
+		interfaceSchemaNames:    make(map[string]struct{}),
+		mapClassNames:           make(map[string]struct{}),
+		readonlyClassNames:      make(map[string]struct{}),
+		downgrades:              make(map[Downgrade]struct{}),
 	}
 }
 
@@ -80,7 +239,31 @@ func (g *Generator) Load(spec *v3.Document) error {
 		return fmt.Errorf("nil spec")
 	}
 
+	php, err := parsePHPVersion(g.cfg.PHPVersion)
+	if err != nil {
+		return err
+	}
+	if g.cfg.ReadonlyResponses && !php.supportsReadonlyProperties() {
+		return fmt.Errorf("readonly responses require php 8.1 or later, targeting %s", php)
+	}
+	g.php = php
+
+	placement, err := parseModelPlacement(g.cfg.ModelPlacement)
+	if err != nil {
+		return err
+	}
+	g.placement = placement
+
+	if err := validateConfig(g.cfg); err != nil {
+		return err
+	}
+	if g.cfg.Namespace != "" {
+		g.namespace = g.cfg.Namespace
+	}
+	g.layout = g.cfg.Layout.withDefaults()
+
 	g.spec = spec
+	g.nodePointers = nil
 	g.tagLookup = make(map[string]*base.Tag)
 	for _, tag := range spec.Tags {
 		if tag == nil {
@@ -89,11 +272,19 @@ func (g *Generator) Load(spec *v3.Document) error {
 		g.tagLookup[normalizeTagKey(tag.Name)] = tag
 	}
 
+	g.claimServiceNames()
+	g.claimComponentClassNames()
 	usage := g.collectSchemaUsage()
 	g.schemasByTag, g.schemaNamespaces = g.assignSchemasToTags(usage)
+	g.interfaceSchemaNames = g.collectInterfaceSchemaNames(usage)
+	g.mapClassNames = g.collectMapClassNames()
 	g.operationsByTag = g.collectOperations()
+	g.claimOperationClassNames()
 	g.collectRequestClassNames()
 	g.enumsByTag, g.enumNamespaces = g.collectEnums()
+	if g.cfg.ReadonlyResponses {
+		g.readonlyClassNames = g.collectReadonlyClassNames()
+	}
 
 	return nil
 }
@@ -104,6 +295,17 @@ func (g *Generator) Build() error {
 		return fmt.Errorf("missing specs: call Load to load the specs first")
 	}
 
+	previous, hasManifest, err := g.readManifest()
+	if err != nil {
+		return err
+	}
+	g.generated = manifest{Files: make(map[string]string)}
+	g.emitted = Report{}
+	g.tagDirs = nil
+	g.hookFiles = make(map[string]struct{})
+	g.classSchemas = make(map[*php.Class]*base.SchemaProxy)
+	g.classOperations = make(map[*php.Class]map[string]*operation)
+
 	tagSet := make(map[string]struct{})
 	for tag := range g.schemasByTag {
 		tagSet[tag] = struct{}{}
@@ -147,76 +349,53 @@ func (g *Generator) Build() error {
 		return err
 	}
 
-	return nil
-}
-
-func (g *Generator) writeTagFile(tagKey string, schemas []*base.SchemaProxy, operations []*operation) error {
-	tagName := g.displayTagName(tagKey)
-	namespace := g.namespaceForTag(tagKey)
-	includeService := g.shouldIncludeService(tagKey, operations)
-
-	dir := filepath.Join(g.cfg.Out, tagName)
-	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-		return fmt.Errorf("create tag directory: %w", err)
+	if err := g.runBuildHooks(); err != nil {
+		return err
 	}
 
-	filename := filepath.Join(dir, fmt.Sprintf("%s.php", tagName))
-	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if hasManifest {
+		err = g.pruneStaleFiles(previous)
+	} else if g.cfg.CleanWithoutManifest {
+		err = g.removeUnlistedFiles()
+	}
 	if err != nil {
-		return fmt.Errorf("open %q: %w", filename, err)
+		return err
 	}
-	defer func() {
-		_ = f.Close()
-	}()
 
-	var buf bytes.Buffer
-	buf.WriteString("<?php\n\ndeclare(strict_types=1);\n\n")
-	fmt.Fprintf(&buf, "namespace %s;\n", namespace)
+	return g.writeManifest()
+}
 
-	hasEnums := false
-	if enums, ok := g.enumsByTag[tagKey]; ok && len(enums) > 0 {
-		hasEnums = true
-	}
-	hasSchemas := len(schemas) > 0
-	hasTagContent := hasEnums || hasSchemas
-	if hasTagContent {
-		buf.WriteString("\n")
-	}
+func (g *Generator) writeTagFile(tagKey string, schemas []*base.SchemaProxy, operations []*operation) error {
+	tagName := g.displayTagName(tagKey)
+	namespace := g.namespaceForTag(tagKey)
+	includeService := g.shouldIncludeService(tagKey, operations)
 
-	// Write enums first if any exist for this tag
-	if enums, ok := g.enumsByTag[tagKey]; ok && len(enums) > 0 {
-		for idx, enum := range enums {
-			enumCode := g.buildPHPEnum(enum)
-			buf.WriteString(enumCode)
-			if idx < len(enums)-1 || len(schemas) > 0 {
-				buf.WriteString("\n")
-			}
-		}
+	tagNamespace := &php.Namespace{Name: namespace}
+	for _, enum := range g.enumsByTag[tagKey] {
+		tagNamespace.Decls = append(tagNamespace.Decls, g.buildPHPEnum(enum))
 	}
-
-	// Write classes
-	for idx, schema := range schemas {
-		className := schemaClassName(schema)
-		classCode := g.buildPHPClass(className, schema, namespace)
-		buf.WriteString(classCode)
-		if idx < len(schemas)-1 {
-			buf.WriteString("\n")
+	interfaceNames := slices.Collect(maps.Keys(g.interfaceSchemaNames))
+	slices.Sort(interfaceNames)
+	for _, className := range interfaceNames {
+		if g.schemaNamespaces[className] == namespace {
+			tagNamespace.Decls = append(tagNamespace.Decls, g.buildPHPInterface(className))
 		}
 	}
-
-	if includeService {
-		buf.WriteString("\n")
-		buf.WriteString(g.buildServiceBlock(tagKey, operations))
+	for _, schema := range schemas {
+		tagNamespace.Decls = append(tagNamespace.Decls, g.buildPHPClass(g.classNameForSchema(schema), schema, namespace))
 	}
 
-	if _, err := f.Write(buf.Bytes()); err != nil {
-		return fmt.Errorf("write file %q: %w", filename, err)
+	file := &php.File{
+		StrictTypes: true,
+		Namespaces:  []*php.Namespace{tagNamespace},
 	}
-
 	if includeService {
-		if err := g.removeLegacyServiceFile(tagName); err != nil {
-			return err
-		}
+		file.Namespaces = append(file.Namespaces, g.buildServiceNamespace(tagKey, operations))
+	}
+	filename := path.Join(tagName, tagName+".php")
+	g.tagDirs = append(g.tagDirs, tagName)
+	if err := g.writePHPFile(filename, file); err != nil {
+		return err
 	}
 
 	enumCount := 0
@@ -232,7 +411,7 @@ func (g *Generator) writeTagFile(tagKey string, schemas []*base.SchemaProxy, ope
 	slog.Info("generated tag file",
 		slog.String("tag", tagName),
 		slog.String("namespace", namespace),
-		slog.String("file", filename),
+		slog.String("file", filepath.Join(g.cfg.Out, filename)),
 		slog.Int("classes", len(schemas)),
 		slog.Int("enums", enumCount),
 		slog.Int("services", serviceCount),
@@ -241,131 +420,468 @@ func (g *Generator) writeTagFile(tagKey string, schemas []*base.SchemaProxy, ope
 	return nil
 }
 
-func (g *Generator) removeLegacyServiceFile(className string) error {
-	servicesDir := filepath.Join(g.cfg.Out, "Services")
-	filename := filepath.Join(servicesDir, fmt.Sprintf("%s.php", className))
-	if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
-		return fmt.Errorf("remove legacy service file %q: %w", filename, err)
+func (g *Generator) buildPHPClass(name string, schema *base.SchemaProxy, currentNamespace string) *php.Class {
+	if schemaIsMapClass(schema) {
+		class := g.buildPHPMapClass(name, schema, currentNamespace)
+		g.classSchemas[class] = schema
+		return class
 	}
-	return nil
-}
 
-func (g *Generator) buildPHPClass(name string, schema *base.SchemaProxy, currentNamespace string) string {
-	var buf strings.Builder
-	description := ""
-	if schema.Schema() != nil {
-		description = schema.Schema().Description
+	class := &php.Class{Name: name}
+	g.classSchemas[class] = schema
+	if spec := schema.Schema(); spec != nil {
+		class.Doc = php.DocBlock{spec.Description}
+		if status := specLifecycle(spec.Deprecated, spec.Extensions).doc(); len(status) > 0 {
+			class.Doc = append(class.Doc, "")
+			class.Doc = append(class.Doc, status...)
+		}
 	}
 
-	if description != "" {
-		buf.WriteString("/**\n")
-		for _, line := range strings.Split(description, "\n") {
-			line = strings.TrimSpace(line)
-			if line == "" {
-				buf.WriteString(" *\n")
-				continue
+	properties := g.classProperties(name, schema, currentNamespace)
+	tracksExplicitFields := len(properties) > 0 && g.shouldTrackExplicitFields(name)
+	if tracksExplicitFields {
+		// Sending a default with a partial update would overwrite the
+		// current value, so untouched fields must stay null.
+		for idx := range properties {
+			if properties[idx].ConstName == "" {
+				properties[idx].Default = ""
 			}
-			buf.WriteString(" * ")
-			buf.WriteString(line)
-			buf.WriteString("\n")
 		}
-		buf.WriteString(" */\n")
 	}
 
-	fmt.Fprintf(&buf, "class %s\n{\n", name)
+	// Properties inherited from the base class are declared there only.
+	ownProperties := properties
+	if baseSchema := schemaBaseClass(schema); baseSchema != nil {
+		class.Extends, _ = g.resolvePHPType(baseSchema, currentNamespace, "", "")
+		ownProperties = withoutInheritedProperties(properties, g.collectSchemaPropertyEntries(baseSchema))
+	}
+
+	class.Implements = g.classInterfaces(name, schema, currentNamespace)
+	// Subclasses inherit the additional properties bag from their parent.
+	if class.Extends == "" {
+		class.Implements = append(class.Implements, g.runtimeName("AdditionalPropertiesInterface"))
+	}
+	if tracksExplicitFields {
+		class.Implements = append(class.Implements, g.runtimeName("ExplicitFieldsInterface"))
+	}
 
-	properties := g.schemaProperties(schema, currentNamespace, name)
-	if len(properties) == 0 {
-		buf.WriteString("}\n")
-		return buf.String()
+	readonly := g.isReadonlyClass(name)
+	// Before PHP 8.2 the properties are declared readonly one by one.
+	class.Readonly = readonly && g.php.supportsReadonlyClasses()
+
+	if class.Extends == "" && !readonly {
+		class.Traits = append(class.Traits, g.runtimeName("AdditionalPropertiesTrait"))
 	}
 
-	for _, prop := range properties {
-		propCode := g.renderProperty(prop)
-		buf.WriteString(propCode)
+	for _, prop := range ownProperties {
+		if prop.ConstName != "" {
+			class.Constants = append(class.Constants, buildClassConstant(prop))
+		}
+	}
+
+	for _, prop := range ownProperties {
+		class.Properties = append(class.Properties, g.buildProperty(prop, readonly))
+	}
+
+	if readonly {
+		if class.Extends == "" {
+			class.Properties = append(class.Properties, g.readonlyAdditionalProperties())
+		}
+		class.Methods = g.buildReadonlyMethods(properties, ownProperties, class.Extends == "")
+		return class
+	}
+
+	// Request payloads send a default of the specs only when it is changed
+	// or explicitly set, which fromArray() records.
+	recordsExplicitFields := tracksExplicitFields || (g.shouldGenerateConstructorForClass(name) && slices.ContainsFunc(properties, phpProperty.hasDefault))
+	if recordsExplicitFields {
+		doc := "Properties explicitly set through fromArray()."
+		if tracksExplicitFields {
+			doc = "Properties explicitly set through fromArray(), setters, or the constructor with a non-null value."
+		}
+		class.Properties = append(class.Properties, php.Property{
+			Doc:        php.DocBlock{doc, "", "@var array<string, true>"},
+			Visibility: "private",
+			Type:       "array",
+			Name:       "explicitFields",
+			Default:    "[]",
+		})
 	}
 
 	if g.shouldGenerateConstructorForClass(name) {
-		buf.WriteString(g.buildRequestConstructor(properties))
+		class.Methods = append(class.Methods, g.buildRequestConstructor(properties, tracksExplicitFields, recordsExplicitFields)...)
+	}
+
+	class.Methods = append(class.Methods, g.buildArrayMethods(name, schema, properties, tracksExplicitFields)...)
+
+	if tracksExplicitFields {
+		class.Methods = append(class.Methods, g.buildExplicitFieldMethods(properties)...)
+	}
+	if tracksExplicitFields {
+		class.Methods = append(class.Methods, buildMarkExplicitFieldsMethod(properties))
+	} else if recordsExplicitFields {
+		class.Methods = append(class.Methods, buildMarkExplicitFieldsMethod(slices.DeleteFunc(slices.Clone(properties), func(prop phpProperty) bool {
+			return !prop.hasDefault()
+		})))
 	}
 
-	buf.WriteString("}\n")
-	return buf.String()
+	return class
 }
 
-func (g *Generator) buildRequestConstructor(properties []phpProperty) string {
-	var buf strings.Builder
+// buildPHPMapClass builds a named map with typed values as an ArrayObject
+// subclass. VALUE_TYPE tells the Hydrator what to hydrate each value into.
+func (g *Generator) buildPHPMapClass(name string, schema *base.SchemaProxy, currentNamespace string) *php.Class {
+	valueType, valueDoc := g.resolvePHPType(schemaMapValues(schema), currentNamespace, "", "")
 
-	buf.WriteString("    /**\n")
-	buf.WriteString("     * Create request DTO.\n")
-	buf.WriteString("     *\n")
-	for _, prop := range constructorProperties(properties) {
-		fmt.Fprintf(&buf, "     * @param %s $%s\n", g.constructorParamDocType(prop), prop.Name)
+	valueTypeConstant := php.Constant{
+		Doc:        php.DocBlock{"Type each value of the map is hydrated into."},
+		Visibility: "public",
+		Name:       "VALUE_TYPE",
+		Value:      php.Expr(valueType + "::class"),
 	}
-	buf.WriteString("     */\n")
-	buf.WriteString("    public function __construct(")
-	constructorProps := constructorProperties(properties)
-	if len(constructorProps) > 0 {
-		buf.WriteString("\n")
-		for idx, prop := range constructorProps {
-			buf.WriteString("        ")
-			buf.WriteString(g.constructorParamType(prop))
-			buf.WriteString(" $")
-			buf.WriteString(prop.Name)
-			if prop.Optional {
-				buf.WriteString(" = null")
-			}
-			if idx < len(constructorProps)-1 {
-				buf.WriteString(",")
+	if isBuiltinPHPType(valueType) {
+		valueTypeConstant.Value = php.String(valueType)
+	}
+
+	return &php.Class{
+		Doc: php.DocBlock{
+			schema.Schema().Description,
+			"",
+			fmt.Sprintf("@extends \\ArrayObject<string, %s>", valueDoc),
+		},
+		Name:      name,
+		Extends:   "\\ArrayObject",
+		Constants: []php.Constant{valueTypeConstant},
+		Methods:   g.buildMapArrayMethods(valueType),
+	}
+}
+
+func (g *Generator) collectMapClassNames() map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, schemas := range g.schemasByTag {
+		for _, schema := range schemas {
+			if schemaIsMapClass(schema) {
+				result[g.classNameForSchema(schema)] = struct{}{}
 			}
-			buf.WriteString("\n")
 		}
-		buf.WriteString("    ")
 	}
-	buf.WriteString(") {\n")
-	buf.WriteString("        \\SumUp\\Hydrator::hydrate([\n")
+	return result
+}
+
+func (g *Generator) isMapClass(typeName string) bool {
+	className := phpClassBaseName(strings.TrimPrefix(typeName, "?"))
+	if className == "" {
+		return false
+	}
+	_, ok := g.mapClassNames[className]
+	return ok
+}
+
+// classInterfaces returns the marker interfaces a class implements: its own
+// interface when it is one of several allOf parents, and the interfaces of
+// its parents when it is composed from more than one of them.
+func (g *Generator) classInterfaces(className string, schema *base.SchemaProxy, currentNamespace string) []string {
+	interfaces := make([]string, 0)
+	if _, ok := g.interfaceSchemaNames[className]; ok {
+		interfaces = append(interfaces, g.interfaceReference(className, currentNamespace))
+	}
+
+	parents := schemaParentRefs(schema)
+	if len(parents) < 2 {
+		return interfaces
+	}
+	for _, parent := range parents {
+		interfaces = append(interfaces, g.interfaceReference(g.classNameForSchema(parent), currentNamespace))
+	}
+	return interfaces
+}
+
+func (g *Generator) interfaceReference(className string, currentNamespace string) string {
+	name := g.interfaceName(className)
+	namespace := g.schemaNamespaces[className]
+	if namespace == "" || namespace == currentNamespace {
+		return name
+	}
+	return fmt.Sprintf("\\%s\\%s", namespace, name)
+}
+
+func (g *Generator) collectInterfaceSchemaNames(usage map[string]*schemaUsage) map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, info := range usage {
+		parents := schemaParentRefs(info.schema)
+		if len(parents) < 2 {
+			continue
+		}
+		for _, parent := range parents {
+			result[g.classNameForSchema(parent)] = struct{}{}
+		}
+	}
+	for _, className := range slices.Sorted(maps.Keys(result)) {
+		g.interfaceName(className)
+	}
+	return result
+}
+
+// interfaceName returns the name of the marker interface of a class, which
+// shares the model namespaces and so the registry of the model names.
+func (g *Generator) interfaceName(className string) string {
+	return g.modelNames.claim(interfaceOwner(className), phpInterfaceName(className))
+}
+
+func (g *Generator) buildPHPInterface(className string) *php.Interface {
+	return &php.Interface{
+		Doc:  php.DocBlock{fmt.Sprintf("Implemented by %s and every schema composed from it.", className)},
+		Name: g.interfaceName(className),
+	}
+}
+
+// classProperties returns the properties of a class including inherited ones.
+// Inherited properties are resolved against the class declaring them, so that
+// their inline enums and objects keep the names of the parent class.
+func (g *Generator) classProperties(name string, schema *base.SchemaProxy, currentNamespace string) []phpProperty {
+	properties := g.schemaProperties(schema, currentNamespace, name)
+	baseSchema := schemaBaseClass(schema)
+	if baseSchema == nil {
+		return properties
+	}
+
+	inherited := make(map[string]phpProperty)
+	for _, prop := range g.classProperties(g.classNameForSchema(baseSchema), baseSchema, currentNamespace) {
+		inherited[prop.SerializedName] = prop
+	}
+	for idx, prop := range properties {
+		if parentProp, ok := inherited[prop.SerializedName]; ok {
+			properties[idx] = parentProp
+		}
+	}
+	return properties
+}
+
+func withoutInheritedProperties(properties []phpProperty, inherited []schemaPropertyEntry) []phpProperty {
+	inheritedNames := make(map[string]struct{}, len(inherited))
+	for _, entry := range inherited {
+		inheritedNames[entry.Name] = struct{}{}
+	}
+
+	result := make([]phpProperty, 0, len(properties))
+	for _, prop := range properties {
+		if _, ok := inheritedNames[prop.SerializedName]; ok {
+			continue
+		}
+		result = append(result, prop)
+	}
+	return result
+}
+
+func (g *Generator) buildRequestConstructor(properties []phpProperty, tracksExplicitFields, recordsExplicitFields bool) []php.Method {
+	constructorProps := constructorProperties(properties)
+	constructor := php.Method{
+		Doc:  php.DocBlock{"Create request DTO.", ""},
+		Name: "__construct",
+	}
+	if tracksExplicitFields {
+		// PHP cannot tell an omitted argument from an explicit null, so only
+		// non-null arguments are recorded.
+		constructor.Doc = append(constructor.Doc,
+			"Null arguments are left out of the payload. Send an explicit null",
+			"through a setter or fromArray() to clear a field.",
+			"",
+		)
+	}
 	for _, prop := range constructorProps {
-		fmt.Fprintf(&buf, "            '%s' => $%s,\n", prop.SerializedName, prop.Name)
-	}
-	buf.WriteString("        ], self::class, $this);\n")
-	buf.WriteString("    }\n\n")
-
-	buf.WriteString("    /**\n")
-	buf.WriteString("     * Create request DTO from an associative array.\n")
-	buf.WriteString("     *\n")
-	buf.WriteString("     * @param array<string, mixed> $data\n")
-	buf.WriteString("     */\n")
-	buf.WriteString("    public static function fromArray(array $data): self\n")
-	buf.WriteString("    {\n")
+		constructor.Doc = append(constructor.Doc, fmt.Sprintf("@param %s $%s", g.constructorParamDocType(prop), prop.Name))
+		constructor.Params = append(constructor.Params, g.constructorParam(prop))
+	}
+
+	var body strings.Builder
+	for _, prop := range constructorProps {
+		if trigger := g.deprecationTrigger("$"+prop.Name, prop.lifecycle); trigger != "" {
+			fmt.Fprintf(&body, "if ($%s !== null) {\n", prop.Name)
+			body.WriteString("    " + trigger)
+			body.WriteString("}\n")
+		}
+	}
+	if body.Len() > 0 {
+		body.WriteString("\n")
+	}
+	body.WriteString("$this->fill([\n")
+	for _, prop := range constructorProps {
+		fmt.Fprintf(&body, "    %s => $%s,\n", phpString(prop.SerializedName), prop.Name)
+	}
+	body.WriteString("]);\n")
+	if tracksExplicitFields {
+		body.WriteString("\n")
+		body.WriteString("foreach ([\n")
+		for _, prop := range constructorProps {
+			fmt.Fprintf(&body, "    %s => $%s,\n", phpString(prop.Name), prop.Name)
+		}
+		body.WriteString("] as $propertyName => $value) {\n")
+		body.WriteString("    if ($value !== null) {\n")
+		body.WriteString("        $this->explicitFields[$propertyName] = true;\n")
+		body.WriteString("    }\n")
+		body.WriteString("}\n")
+	}
+	constructor.Body = body.String()
+
+	body.Reset()
 	requiredProps := requiredProperties(properties)
+	body.WriteString(g.newInstanceStatement("$request", requiredProps))
+	body.WriteString("$request->fill($data);\n")
+	if recordsExplicitFields {
+		body.WriteString("$request->markExplicitFields($data);\n")
+	}
+	body.WriteString("\n")
+	body.WriteString("return $request;\n")
+
+	methods := []php.Method{
+		constructor,
+		{
+			Doc: php.DocBlock{
+				"Create request DTO from an associative array.",
+				"",
+				"@param array<string, mixed> $data",
+			},
+			Static:     true,
+			Name:       "fromArray",
+			Params:     []php.Param{{Type: "array", Name: "data"}},
+			ReturnType: "self",
+			Body:       body.String(),
+		},
+	}
+
 	if len(requiredProps) > 0 {
-		buf.WriteString("        self::assertRequiredFields($data, [\n")
-		for _, prop := range requiredProps {
-			fmt.Fprintf(&buf, "            '%s' => '%s',\n", prop.SerializedName, prop.Name)
+		methods = append(methods, buildRequiredFieldsMethod())
+	}
+
+	return methods
+}
+
+// newInstanceStatement renders the construction of an instance in
+// fromArray(). The constructor cannot be bypassed without reflection, so the
+// required fields of the payload are hydrated and passed to it.
+func (g *Generator) newInstanceStatement(variable string, required []phpProperty) string {
+	if len(required) == 0 {
+		return variable + " = new self();\n"
+	}
+
+	var b strings.Builder
+	b.WriteString("$required = self::requiredFields($data, [\n")
+	for _, prop := range required {
+		fmt.Fprintf(&b, "    %s => %s,\n", phpString(prop.SerializedName), phpString(prop.Name))
+	}
+	b.WriteString("]);\n\n")
+	fmt.Fprintf(&b, "%s = new self(\n", variable)
+	for idx, prop := range required {
+		value := fmt.Sprintf("$required[%s]", phpString(prop.Name))
+		b.WriteString("    " + g.hydrateExpression(prop.Type, prop.DocType, value, prop.nullable()))
+		if idx < len(required)-1 {
+			b.WriteString(",")
 		}
-		buf.WriteString("        ]);\n\n")
+		b.WriteString("\n")
 	}
-	buf.WriteString("        $request = (new \\ReflectionClass(self::class))->newInstanceWithoutConstructor();\n")
-	buf.WriteString("        \\SumUp\\Hydrator::hydrate($data, self::class, $request);\n\n")
-	buf.WriteString("        return $request;\n")
-	buf.WriteString("    }\n\n")
+	b.WriteString(");\n")
+	return b.String()
+}
 
-	if len(requiredProps) > 0 {
-		buf.WriteString("    /**\n")
-		buf.WriteString("     * @param array<string, mixed> $data\n")
-		buf.WriteString("     * @param array<string, string> $requiredFields\n")
-		buf.WriteString("     */\n")
-		buf.WriteString("    private static function assertRequiredFields(array $data, array $requiredFields): void\n")
-		buf.WriteString("    {\n")
-		buf.WriteString("        foreach ($requiredFields as $serializedName => $propertyName) {\n")
-		buf.WriteString("            if (!array_key_exists($serializedName, $data) && !array_key_exists($propertyName, $data)) {\n")
-		buf.WriteString("                throw new \\InvalidArgumentException(sprintf('Missing required field \"%s\".', $serializedName));\n")
-		buf.WriteString("            }\n")
-		buf.WriteString("        }\n")
-		buf.WriteString("    }\n\n")
+// buildRequiredFieldsMethod builds the helper of newInstanceStatement
+// returning the required fields of a payload, keyed by property name.
+func buildRequiredFieldsMethod() php.Method {
+	var body strings.Builder
+	body.WriteString("$values = [];\n")
+	body.WriteString("foreach ($requiredFields as $serializedName => $propertyName) {\n")
+	body.WriteString("    if (array_key_exists($serializedName, $data)) {\n")
+	body.WriteString("        $values[$propertyName] = $data[$serializedName];\n")
+	body.WriteString("    } elseif (array_key_exists($propertyName, $data)) {\n")
+	body.WriteString("        $values[$propertyName] = $data[$propertyName];\n")
+	body.WriteString("    } else {\n")
+	body.WriteString("        throw new \\InvalidArgumentException(sprintf('Missing required field \"%s\".', $serializedName));\n")
+	body.WriteString("    }\n")
+	body.WriteString("}\n\n")
+	body.WriteString("return $values;\n")
+	return php.Method{
+		Doc: php.DocBlock{
+			"@param array<string, mixed> $data",
+			"@param array<string, string> $requiredFields",
+			"",
+			"@return array<string, mixed>",
+		},
+		Visibility: "private",
+		Static:     true,
+		Name:       "requiredFields",
+		Params: []php.Param{
+			{Type: "array", Name: "data"},
+			{Type: "array", Name: "requiredFields"},
+		},
+		ReturnType: "array",
+		Body:       body.String(),
+	}
+}
+
+func (g *Generator) buildExplicitFieldMethods(properties []phpProperty) []php.Method {
+	methods := make([]php.Method, 0, len(properties)+1)
+
+	for _, prop := range properties {
+		summary := fmt.Sprintf("Set %s.", prop.Name)
+		if prop.Optional {
+			summary = fmt.Sprintf("Set %s, sending an explicit null when the value is null.", prop.Name)
+		}
+		param := g.constructorParam(prop)
+		param.Default = ""
+
+		var body strings.Builder
+		body.WriteString(g.deprecationTrigger("$"+prop.Name, prop.lifecycle))
+		fmt.Fprintf(&body, "$this->fill([%s => $%s]);\n", phpString(prop.SerializedName), prop.Name)
+		fmt.Fprintf(&body, "$this->explicitFields[%s] = true;\n\n", phpString(prop.Name))
+		body.WriteString("return $this;\n")
+
+		methods = append(methods, php.Method{
+			Doc: append(php.DocBlock{
+				summary,
+				"",
+				fmt.Sprintf("@param %s $%s", g.constructorParamDocType(prop), prop.Name),
+			}, prop.doc()...),
+			Name:       phpSetterName(prop.Name),
+			Params:     []php.Param{param},
+			ReturnType: "self",
+			Body:       body.String(),
+		})
 	}
 
-	return buf.String()
+	methods = append(methods, php.Method{
+		Doc: php.DocBlock{
+			"Names of the properties explicitly set, including those set to null.",
+			"",
+			"@return string[]",
+		},
+		Name:       "explicitFields",
+		ReturnType: "array",
+		Body:       "return array_keys($this->explicitFields);",
+	})
+
+	return methods
+}
+
+// buildMarkExplicitFieldsMethod builds markExplicitFields(), which records
+// the fields of a fromArray() payload as explicitly set.
+func buildMarkExplicitFieldsMethod(properties []phpProperty) php.Method {
+	var body strings.Builder
+	body.WriteString("foreach ([\n")
+	for _, prop := range properties {
+		fmt.Fprintf(&body, "    %s => %s,\n", phpString(prop.SerializedName), phpString(prop.Name))
+	}
+	body.WriteString("] as $serializedName => $propertyName) {\n")
+	body.WriteString("    if (array_key_exists($serializedName, $data) || array_key_exists($propertyName, $data)) {\n")
+	body.WriteString("        $this->explicitFields[$propertyName] = true;\n")
+	body.WriteString("    }\n")
+	body.WriteString("}\n")
+	return php.Method{
+		Doc:        php.DocBlock{"@param array<string, mixed> $data"},
+		Visibility: "private",
+		Name:       "markExplicitFields",
+		Params:     []php.Param{{Type: "array", Name: "data"}},
+		ReturnType: "void",
+		Body:       body.String(),
+	}
 }
 
 func constructorProperties(properties []phpProperty) []phpProperty {
@@ -383,6 +899,26 @@ func constructorProperties(properties []phpProperty) []phpProperty {
 	return result
 }
 
+func constructorParamDefault(prop phpProperty) string {
+	if prop.Default != "" {
+		return prop.Default
+	}
+	return "null"
+}
+
+// constructorParam declares the parameter accepting a property, as used by
+// constructors, setters and withers.
+func (g *Generator) constructorParam(prop phpProperty) php.Param {
+	param := php.Param{
+		Type: g.php.typeHint(g.constructorParamType(prop)),
+		Name: prop.Name,
+	}
+	if prop.Optional {
+		param.Default = php.Expr(constructorParamDefault(prop))
+	}
+	return param
+}
+
 func requiredProperties(properties []phpProperty) []phpProperty {
 	result := make([]phpProperty, 0, len(properties))
 	for _, prop := range properties {
@@ -401,7 +937,10 @@ func (g *Generator) constructorParamDocType(prop phpProperty) string {
 	if backingType := g.enumBackingType(prop.Type); backingType != "" {
 		docType += "|" + backingType
 	}
-	if prop.Optional && !strings.Contains(docType, "null") {
+	if g.isMapClass(prop.Type) {
+		docType += "|array<string, mixed>"
+	}
+	if prop.nullable() && !strings.Contains(docType, "null") {
 		docType += "|null"
 	}
 	return docType
@@ -416,8 +955,11 @@ func (g *Generator) constructorParamType(prop phpProperty) string {
 	if backingType := g.enumBackingType(paramType); backingType != "" {
 		paramType += "|" + backingType
 	}
+	if g.isMapClass(paramType) {
+		paramType += "|array"
+	}
 
-	if prop.Optional && paramType != "mixed" {
+	if prop.nullable() && paramType != "mixed" {
 		if strings.Contains(paramType, "|") {
 			if !strings.Contains(paramType, "null") {
 				paramType += "|null"
@@ -455,20 +997,47 @@ func (g *Generator) enumBackingType(typeName string) string {
 
 func (g *Generator) collectRequestClassNames() {
 	g.requestClassNames = make(map[string]struct{})
-	for _, operations := range g.operationsByTag {
+	g.explicitFieldClassNames = make(map[string]struct{})
+	for tagKey, operations := range g.operationsByTag {
 		for _, op := range operations {
-			if op == nil || !op.HasBody || op.BodyType == "" {
+			if op == nil || !op.HasBody {
 				continue
 			}
-			className := phpClassBaseName(op.BodyType)
-			if className == "" || isBuiltinPHPType(className) {
-				continue
+
+			className := ""
+			if shouldGenerateRequestBodyClass(op) {
+				className = g.requestBodyClassName(g.displayTagName(tagKey), op)
+			} else if op.BodyType != "" {
+				className = phpClassBaseName(op.BodyType)
+				if className == "" || isBuiltinPHPType(className) {
+					continue
+				}
+				g.requestClassNames[className] = struct{}{}
+			}
+
+			if className != "" && methodSupportsExplicitNulls(op.Method) {
+				g.explicitFieldClassNames[className] = struct{}{}
 			}
-			g.requestClassNames[className] = struct{}{}
 		}
 	}
 }
 
+// methodSupportsExplicitNulls reports whether request bodies sent with the
+// HTTP method may clear fields by sending an explicit null.
+func methodSupportsExplicitNulls(method string) bool {
+	switch strings.ToUpper(method) {
+	case "PATCH", "PUT":
+		return true
+	default:
+		return false
+	}
+}
+
+func (g *Generator) shouldTrackExplicitFields(className string) bool {
+	_, ok := g.explicitFieldClassNames[className]
+	return ok
+}
+
 func (g *Generator) shouldGenerateConstructorForClass(className string) bool {
 	if className == "" || className == "BadRequest" {
 		return false
@@ -500,16 +1069,21 @@ func isBuiltinPHPType(typeName string) bool {
 
 func (g *Generator) displayTagName(tagKey string) string {
 	if tagKey == sharedTagKey {
-		return sharedTagDisplayName
+		return g.layout.Shared
 	}
 	if tagKey == typesTagKey {
-		return typesTagDisplayName
+		return g.layout.Types
 	}
 
+	return g.serviceNames.claim(tagOwner(tagKey), g.serviceBaseName(tagKey))
+}
+
+// serviceBaseName returns the name of the service of a tag before collisions
+// are resolved.
+func (g *Generator) serviceBaseName(tagKey string) string {
 	if tag, ok := g.tagLookup[tagKey]; ok && tag != nil && tag.Name != "" {
 		return sanitizeTagName(tag.Name)
 	}
-
 	return sanitizeTagName(tagKey)
 }
 
@@ -522,58 +1096,80 @@ func (g *Generator) tagDescription(tagKey string) string {
 
 func (g *Generator) namespaceForTag(tagKey string) string {
 	if tagKey == sharedTagKey {
-		return sharedTagNamespace
+		return g.sharedNamespace()
 	}
 	if tagKey == typesTagKey {
-		return typesNamespace
+		return g.typesNamespace()
 	}
 
-	tagName := g.displayTagName(tagKey)
-	return fmt.Sprintf("SumUp\\%s", tagName)
+	return g.subNamespace(g.displayTagName(tagKey))
 }
 
-func (g *Generator) buildPHPEnum(enum enumDefinition) string {
-	var buf strings.Builder
+func (g *Generator) buildPHPEnum(enum enumDefinition) php.Decl {
+	if !g.php.supportsEnums() {
+		return buildPHPEnumConstants(enum)
+	}
 
-	if enum.Description != "" {
-		buf.WriteString("/**\n")
-		for _, line := range strings.Split(enum.Description, "\n") {
-			line = strings.TrimSpace(line)
-			if line == "" {
-				buf.WriteString(" *\n")
-				continue
-			}
-			buf.WriteString(" * ")
-			buf.WriteString(line)
-			buf.WriteString("\n")
-		}
-		buf.WriteString(" */\n")
+	decl := &php.Enum{
+		Doc:  php.DocBlock{enum.Description},
+		Name: enum.Name,
+	}
+	if enum.Type == "string" || enum.Type == "int" {
+		decl.BackingType = enum.Type
 	}
 
-	backingType := ""
-	switch enum.Type {
-	case "string":
-		backingType = ": string"
-	case "int":
-		backingType = ": int"
+	for _, value := range enum.Values {
+		decl.Cases = append(decl.Cases, php.EnumCase{
+			Name:  phpEnumCaseName(value),
+			Value: enumCaseValue(enum, value),
+		})
 	}
 
-	fmt.Fprintf(&buf, "enum %s%s\n{\n", enum.Name, backingType)
+	return decl
+}
+
+// buildPHPEnumConstants builds an enum as a class holding a constant per
+// case, for runtimes older than PHP 8.1.
+func buildPHPEnumConstants(enum enumDefinition) *php.Class {
+	class := &php.Class{
+		Doc:   php.DocBlock{enum.Description},
+		Final: true,
+		Name:  enum.Name,
+		Methods: []php.Method{{
+			Visibility: "private",
+			Name:       "__construct",
+		}},
+	}
 
 	for _, value := range enum.Values {
-		caseName := phpEnumCaseName(value)
-		switch enum.Type {
-		case "string":
-			fmt.Fprintf(&buf, "    case %s = '%s';\n", caseName, value)
-		case "int":
-			fmt.Fprintf(&buf, "    case %s = %s;\n", caseName, value)
-		default:
-			fmt.Fprintf(&buf, "    case %s;\n", caseName)
+		caseValue := enumCaseValue(enum, value)
+		if caseValue == "" {
+			caseValue = php.String(value)
 		}
+		class.Constants = append(class.Constants, php.Constant{
+			Visibility: "public",
+			Name:       phpEnumCaseName(value),
+			Value:      caseValue,
+		})
 	}
 
-	buf.WriteString("}\n")
-	return buf.String()
+	return class
+}
+
+// enumCaseValue returns the literal backing an enum case, which is empty for
+// pure enums.
+func enumCaseValue(enum enumDefinition, value string) php.Expr {
+	switch enum.Type {
+	case "string":
+		return php.String(value)
+	case "int":
+		if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return php.Int(number)
+		}
+		return php.String(value)
+	default:
+		return ""
+	}
 }
 
 func (g *Generator) shouldIncludeService(tagKey string, operations []*operation) bool {
@@ -637,7 +1233,12 @@ func (g *Generator) collectEnumsFromSchema(schema *base.SchemaProxy, tagKey stri
 				if schemaName == "" {
 					continue
 				}
-				enumName := phpEnumName(schemaName, propName)
+				// Nested classes are visited from the classes referencing them,
+				// but their enums are defined next to them.
+				if namespace, ok := g.schemaNamespaces[schemaName]; ok && namespace != g.namespaceForTag(tagKey) {
+					continue
+				}
+				enumName := g.modelNames.claim(enumOwner{class: schemaName, property: propName}, phpEnumName(schemaName, propName))
 				if _, seen := enumsSeen[enumName]; seen {
 					continue
 				}
@@ -646,7 +1247,8 @@ func (g *Generator) collectEnumsFromSchema(schema *base.SchemaProxy, tagKey stri
 				enumType := "string"
 				values := make([]string, 0, len(propSpec.Enum))
 				for _, val := range propSpec.Enum {
-					if val != nil && val.Value != "" {
+					// Nullable enums list null among their values.
+					if val != nil && val.Value != "" && val.ShortTag() != "!!null" {
 						values = append(values, val.Value)
 					}
 				}
@@ -0,0 +1,2198 @@
+package generator
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
+	"github.com/sumup/sumup-php/codegen/pkg/surface"
+)
+
+func TestBuildTracksExplicitFieldsForPatchAndPutBodies(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{IncludeBeta: true})
+
+	roles := readGenerated(t, out, "Roles/Roles.php")
+	updateRequest := generatedClass(t, roles, "RolesUpdateRequest")
+	for _, fragment := range []string{
+		"class RolesUpdateRequest implements AdditionalPropertiesInterface, ExplicitFieldsInterface\n",
+		"public function setName(?string $name): self",
+		"public function explicitFields(): array",
+		"$request->markExplicitFields($data);",
+		// Omitted constructor arguments are null too, so null ones are not
+		// recorded.
+		"Null arguments are left out of the payload.",
+		"if ($value !== null) {",
+	} {
+		if !strings.Contains(updateRequest, fragment) {
+			t.Errorf("RolesUpdateRequest does not contain %q:\n%s", fragment, updateRequest)
+		}
+	}
+
+	createRequest := generatedClass(t, roles, "RolesCreateRequest")
+	if strings.Contains(createRequest, "ExplicitFieldsInterface") {
+		t.Errorf("POST body RolesCreateRequest tracks explicit fields:\n%s", createRequest)
+	}
+
+	applePay := generatedClass(t, readGenerated(t, out, "Checkouts/Checkouts.php"), "CheckoutsCreateApplePaySessionRequest")
+	if !strings.Contains(applePay, "public function setContext(string $context): self") {
+		t.Errorf("setter for a required property accepts null:\n%s", applePay)
+	}
+}
+
+func TestBuildRendersAllOfCompositionsAsInheritance(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{})
+
+	checkoutSuccess := readGenerated(t, out, "Types/CheckoutSuccess.php")
+	if !strings.Contains(checkoutSuccess, "class CheckoutSuccess extends Checkout\n") {
+		t.Errorf("CheckoutSuccess does not extend Checkout:\n%s", checkoutSuccess)
+	}
+	if strings.Contains(checkoutSuccess, "$checkoutReference") {
+		t.Errorf("CheckoutSuccess redeclares inherited properties:\n%s", checkoutSuccess)
+	}
+	if !strings.Contains(checkoutSuccess, "$transactionCode") {
+		t.Errorf("CheckoutSuccess is missing its own properties:\n%s", checkoutSuccess)
+	}
+
+	transactionFull := readGenerated(t, out, "Types/TransactionFull.php")
+	want := "class TransactionFull implements TransactionBaseInterface, TransactionCheckoutInfoInterface, TransactionMixinHistoryInterface, AdditionalPropertiesInterface\n"
+	if !strings.Contains(transactionFull, want) {
+		t.Errorf("TransactionFull does not implement its parent interfaces:\n%s", transactionFull)
+	}
+	if !strings.Contains(transactionFull, "$transactionCode") {
+		t.Errorf("TransactionFull does not flatten parent properties:\n%s", transactionFull)
+	}
+
+	if !strings.Contains(readGenerated(t, out, "Types/TransactionBase.php"), "class TransactionBase implements TransactionBaseInterface, AdditionalPropertiesInterface\n") {
+		t.Error("TransactionBase does not implement its interface")
+	}
+	if !strings.Contains(readGenerated(t, out, "Types/TransactionBaseInterface.php"), "interface TransactionBaseInterface\n") {
+		t.Error("TransactionBaseInterface is not generated")
+	}
+}
+
+func TestBuildKeepsUnknownFieldsOnRootClasses(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{})
+
+	checkout := readGenerated(t, out, "Types/Checkout.php")
+	for _, fragment := range []string{
+		"use SumUp\\AdditionalPropertiesInterface;\nuse SumUp\\AdditionalPropertiesTrait;\n",
+		"class Checkout implements AdditionalPropertiesInterface\n",
+		"    use AdditionalPropertiesTrait;\n",
+	} {
+		if !strings.Contains(checkout, fragment) {
+			t.Errorf("Checkout does not contain %q:\n%s", fragment, checkout)
+		}
+	}
+
+	checkoutSuccess := readGenerated(t, out, "Types/CheckoutSuccess.php")
+	if strings.Contains(checkoutSuccess, "AdditionalPropertiesTrait") {
+		t.Errorf("CheckoutSuccess redeclares the bag inherited from Checkout:\n%s", checkoutSuccess)
+	}
+}
+
+func TestBuildRendersReflectionFreeArrayMethods(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{IncludeBeta: true})
+
+	checkout := readGenerated(t, out, "Types/Checkout.php")
+	for _, fragment := range []string{
+		"public static function fromArray(array $data): self",
+		"                case 'checkout_reference':\n                case 'checkoutReference':\n",
+		"$this->currency = $value instanceof CheckoutCurrency || $value === null ? $value : CheckoutCurrency::from($value);",
+		"$this->mandate = $value instanceof MandateResponse || $value === null ? $value : MandateResponse::fromArray($value);",
+		"$data['currency'] = $this->currency->value;",
+		"$data['mandate'] = $this->mandate->toArray();",
+		"return $data + $this->additionalProperties();",
+	} {
+		if !strings.Contains(checkout, fragment) {
+			t.Errorf("Checkout does not contain %q:\n%s", fragment, checkout)
+		}
+	}
+
+	checkoutSuccess := readGenerated(t, out, "Types/CheckoutSuccess.php")
+	if !strings.Contains(checkoutSuccess, "$this->currency = $value instanceof CheckoutCurrency || $value === null ? $value : CheckoutCurrency::from($value);") {
+		t.Errorf("CheckoutSuccess does not hydrate the enum inherited from Checkout:\n%s", checkoutSuccess)
+	}
+
+	roles := readGenerated(t, out, "Roles/Roles.php")
+	listResponse := generatedClass(t, roles, "RolesListResponse")
+	want := "array_map(static fn ($item) => $item instanceof Role ? $item : Role::fromArray($item), (array) $value)"
+	if !strings.Contains(listResponse, want) {
+		t.Errorf("RolesListResponse does not hydrate its items:\n%s", listResponse)
+	}
+
+	updateRequest := generatedClass(t, roles, "RolesUpdateRequest")
+	if !strings.Contains(updateRequest, "} elseif (isset($this->explicitFields['name'])) {") {
+		t.Errorf("RolesUpdateRequest does not serialize explicit nulls:\n%s", updateRequest)
+	}
+	if strings.Contains(roles, "Hydrator::hydrate") {
+		t.Errorf("generated classes still hydrate through reflection:\n%s", roles)
+	}
+
+	meta := readGenerated(t, out, "Types/Meta.php")
+	if !strings.Contains(meta, "return new self(array_map(static fn ($item) => (string) $item, $data));") {
+		t.Errorf("Meta does not cast its values:\n%s", meta)
+	}
+
+	// Request DTOs pass the required fields to their constructor rather than
+	// bypass it through reflection.
+	readerCheckout := readGenerated(t, out, "Types/CreateReaderCheckoutRequest.php")
+	want = "        $request = new self(\n" +
+		"            $required['totalAmount'] instanceof CreateReaderCheckoutRequestTotalAmount ? $required['totalAmount'] : CreateReaderCheckoutRequestTotalAmount::fromArray($required['totalAmount'])\n" +
+		"        );\n"
+	if !strings.Contains(readerCheckout, want) {
+		t.Errorf("CreateReaderCheckoutRequest does not construct itself from the required fields:\n%s", readerCheckout)
+	}
+	refund := generatedClass(t, readGenerated(t, out, "Transactions/Transactions.php"), "TransactionsRefundRequest")
+	if !strings.Contains(refund, "$request = new self();") {
+		t.Errorf("TransactionsRefundRequest does not construct itself without arguments:\n%s", refund)
+	}
+	for _, name := range []string{"Types/CreateReaderCheckoutRequest.php", "Roles/Roles.php", "Transactions/Transactions.php"} {
+		if contents := readGenerated(t, out, name); strings.Contains(contents, "ReflectionClass") {
+			t.Errorf("%s instantiates a class through reflection:\n%s", name, contents)
+		}
+	}
+}
+
+func TestBuildConstructsSubclassesOfRequestsWithoutReflection(t *testing.T) {
+	t.Parallel()
+
+	out := testBuildSpec(t, []byte(`
+openapi: 3.0.3
+info:
+  title: Notes
+  version: 1.0.0
+paths:
+  /notes:
+    post:
+      operationId: CreateNote
+      tags: [Notes]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/NoteInput"
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Note"
+components:
+  schemas:
+    NoteInput:
+      type: object
+      required: [title]
+      properties:
+        title:
+          type: string
+        body:
+          type: string
+    Note:
+      allOf:
+        - $ref: "#/components/schemas/NoteInput"
+        - type: object
+          properties:
+            id:
+              type: string
+`), Config{})
+
+	note := readGenerated(t, out, "Types/Note.php")
+	for _, fragment := range []string{
+		"class Note extends NoteInput",
+		"        $instance = new self(\n            (string) $required['title']\n        );\n",
+		"private static function requiredFields(array $data, array $requiredFields): array",
+	} {
+		if !strings.Contains(note, fragment) {
+			t.Errorf("Note does not contain %q:\n%s", fragment, note)
+		}
+	}
+	if strings.Contains(note, "ReflectionClass") {
+		t.Errorf("Note instantiates itself through reflection:\n%s", note)
+	}
+}
+
+func TestBuildGeneratesReadonlyResponseClasses(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{ReadonlyResponses: true, IncludeBeta: true})
+
+	checkout := readGenerated(t, out, "Types/Checkout.php")
+	for _, fragment := range []string{
+		"readonly class Checkout implements AdditionalPropertiesInterface\n",
+		"    public ?string $checkoutReference;\n",
+		"    protected function __construct(array $data)\n",
+		"public function withCurrency(CheckoutCurrency|string|null $currency): self",
+	} {
+		if !strings.Contains(checkout, fragment) {
+			t.Errorf("Checkout does not contain %q:\n%s", fragment, checkout)
+		}
+	}
+	if strings.Contains(checkout, "AdditionalPropertiesTrait") {
+		t.Errorf("readonly Checkout uses the mutable additional properties trait:\n%s", checkout)
+	}
+
+	checkoutSuccess := readGenerated(t, out, "Types/CheckoutSuccess.php")
+	if !strings.Contains(checkoutSuccess, "readonly class CheckoutSuccess extends Checkout\n") {
+		t.Errorf("CheckoutSuccess is not readonly like its parent:\n%s", checkoutSuccess)
+	}
+	if !strings.Contains(checkoutSuccess, "parent::__construct($inherited);") {
+		t.Errorf("CheckoutSuccess does not leave inherited properties to its parent:\n%s", checkoutSuccess)
+	}
+
+	// Classes reachable from request bodies stay mutable.
+	for _, name := range []string{"Types/CheckoutCreateRequest.php", "Types/Customer.php", "Types/PersonalDetails.php"} {
+		if contents := readGenerated(t, out, name); strings.Contains(contents, "readonly class") {
+			t.Errorf("%s is readonly:\n%s", name, contents)
+		}
+	}
+
+	roles := readGenerated(t, out, "Roles/Roles.php")
+	if !strings.Contains(roles, "readonly class RolesListResponse implements") {
+		t.Errorf("inline response class RolesListResponse is not readonly:\n%s", roles)
+	}
+	if strings.Contains(roles, "readonly class RolesCreateRequest") {
+		t.Errorf("request class RolesCreateRequest is readonly:\n%s", roles)
+	}
+}
+
+func TestBuildTargetsOlderPHPVersions(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{PHPVersion: "7.4", IncludeBeta: true})
+
+	currency := readGenerated(t, out, "Types/CheckoutCurrency.php")
+	for _, fragment := range []string{
+		"final class CheckoutCurrency\n",
+		"    public const EUR = 'EUR';\n",
+	} {
+		if !strings.Contains(currency, fragment) {
+			t.Errorf("CheckoutCurrency does not contain %q:\n%s", fragment, currency)
+		}
+	}
+
+	request := readGenerated(t, out, "Types/CheckoutCreateRequest.php")
+	for _, fragment := range []string{
+		"    public string $currency;\n",
+		"    public ?string $purpose = 'CHECKOUT';\n",
+		"                    $this->currency = (string) $value;\n",
+	} {
+		if !strings.Contains(request, fragment) {
+			t.Errorf("CheckoutCreateRequest does not contain %q:\n%s", fragment, request)
+		}
+	}
+
+	checkouts := readGenerated(t, out, "Checkouts/Checkouts.php")
+	if !strings.Contains(checkouts, "public function create($body, ?RequestOptions $requestOptions = null): Checkout\n") {
+		t.Errorf("create() keeps the union type hint of its body:\n%s", checkouts)
+	}
+	if !strings.Contains(checkouts, "@param CheckoutCreateRequest|array<string, mixed> $body") {
+		t.Errorf("create() does not document the union type of its body:\n%s", checkouts)
+	}
+
+	memberships := readGenerated(t, out, "Memberships/Memberships.php")
+	if strings.Contains(memberships, "mixed $") {
+		t.Errorf("Memberships uses the mixed type hint:\n%s", memberships)
+	}
+
+	readonly := testBuild(t, Config{PHPVersion: "8.1", ReadonlyResponses: true})
+	checkout := readGenerated(t, readonly, "Types/Checkout.php")
+	for _, fragment := range []string{
+		"\nclass Checkout implements AdditionalPropertiesInterface\n",
+		"    public readonly ?CheckoutCurrency $currency;\n",
+		"    private readonly array $additionalProperties;\n",
+	} {
+		if !strings.Contains(checkout, fragment) {
+			t.Errorf("Checkout does not contain %q:\n%s", fragment, checkout)
+		}
+	}
+}
+
+// runtimeSyntax lists the syntax of the PHP runtimes newer than 7.4 that the
+// hand-written runtime must not use below the version introducing it.
+var runtimeSyntax = []struct {
+	since   phpVersion
+	name    string
+	pattern *regexp.Regexp
+}{
+	{phpVersion{8, 0}, "nullsafe operator", regexp.MustCompile(`\?->`)},
+	{phpVersion{8, 0}, "mixed type", regexp.MustCompile(`\bmixed\s+\$|\)\s*:\s*\??mixed\b`)},
+	{phpVersion{8, 0}, "union type", regexp.MustCompile(`(?:^|[(,]|:|public|protected|private)\s*\??[\w\\]+\|[\w\\|]+\s*(?:\$|\{|;)`)},
+	{phpVersion{8, 0}, "static return type", regexp.MustCompile(`\)\s*:\s*static\b`)},
+	{phpVersion{8, 0}, "match expression", regexp.MustCompile(`\bmatch\s*\(`)},
+	{phpVersion{8, 0}, "constructor promotion", regexp.MustCompile(`function\s+__construct\s*\([^)]*\b(?:public|protected|private)\s`)},
+	{phpVersion{8, 0}, "catch without variable", regexp.MustCompile(`\bcatch\s*\([^)$]*\)`)},
+	{phpVersion{8, 0}, "trailing comma in parameters", regexp.MustCompile(`,\s*\)\s*(?::\s*\??[\w\\]+\s*)?\{`)},
+	{phpVersion{8, 0}, "PHP 8.0 function", regexp.MustCompile(`\b(?:str_contains|str_starts_with|str_ends_with|get_debug_type)\s*\(`)},
+	{phpVersion{8, 1}, "enum", regexp.MustCompile(`(?m)^\s*enum\s+\w+`)},
+	{phpVersion{8, 1}, "readonly property", regexp.MustCompile(`\breadonly\s`)},
+	{phpVersion{8, 1}, "never return type", regexp.MustCompile(`\)\s*:\s*never\b`)},
+	{phpVersion{8, 1}, "first-class callable", regexp.MustCompile(`\(\.\.\.\)`)},
+	{phpVersion{8, 1}, "PHP 8.1 function", regexp.MustCompile(`\barray_is_list\s*\(`)},
+}
+
+// phpCommentsAndStrings matches the comments and the quoted strings of a PHP
+// file, which are blanked before looking for syntax.
+var phpCommentsAndStrings = regexp.MustCompile(`(?s)/\*.*?\*/|//[^\n]*|'(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*"`)
+
+func TestRuntimeSupportsMinimumPHPVersion(t *testing.T) {
+	t.Parallel()
+
+	src, err := filepath.Abs(filepath.Join("..", "..", "..", "src"))
+	if err != nil {
+		t.Fatalf("resolve src directory: %v", err)
+	}
+	generated, ok, err := New(Config{Out: src}).readManifest()
+	if err != nil || !ok {
+		t.Fatalf("read manifest of %s: %v", src, err)
+	}
+
+	// The files missing from the manifest are the hand-written runtime the
+	// generated code depends on, apart from the models of the model
+	// directories left by earlier generations.
+	var runtime []string
+	err = filepath.WalkDir(src, func(filename string, entry os.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || filepath.Ext(filename) != ".php" {
+			return err
+		}
+		name, err := filepath.Rel(src, filename)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		if dir, _, _ := strings.Cut(name, "/"); dir == defaultLayout.Types || dir == defaultLayout.Shared {
+			return nil
+		}
+		if _, ok := generated.Files[name]; !ok {
+			runtime = append(runtime, name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("list runtime files: %v", err)
+	}
+	if !slices.Contains(runtime, "HttpClient/CurlClient.php") {
+		t.Fatalf("runtime files = %v, want the HTTP clients", runtime)
+	}
+
+	for _, name := range runtime {
+		contents, err := os.ReadFile(filepath.Join(src, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		code := phpCommentsAndStrings.ReplaceAllString(string(contents), "''")
+		for _, syntax := range runtimeSyntax {
+			if minimumPHPVersion.atLeast(syntax.since) {
+				continue
+			}
+			if match := syntax.pattern.FindString(code); match != "" {
+				t.Errorf("%s uses the %s of PHP %s, the minimum is %s: %q", name, syntax.name, syntax.since, minimumPHPVersion, match)
+			}
+		}
+	}
+}
+
+func TestBuildPlacesModelsByTag(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{ModelPlacement: ModelPlacementTag})
+
+	if _, err := os.Stat(filepath.Join(out, "Types")); !os.IsNotExist(err) {
+		t.Errorf("Types directory exists with tag placement: %v", err)
+	}
+
+	readers := readGenerated(t, out, "Readers/Readers.php")
+	if !strings.Contains(readers, "namespace SumUp\\Readers;\n") {
+		t.Errorf("Readers.php does not declare the Readers namespace")
+	}
+	generatedClass(t, readers, "Reader")
+	if !strings.Contains(readers, "use SumUp\\Shared\\Problem;\n") {
+		t.Errorf("Readers service does not import the shared Problem class")
+	}
+
+	shared := readGenerated(t, out, "Shared/Shared.php")
+	for _, fragment := range []string{
+		"namespace SumUp\\Shared;\n",
+		"\nclass Problem implements AdditionalPropertiesInterface\n",
+		"\ninterface TransactionBaseInterface\n",
+		"\nenum TransactionBaseCurrency: string\n",
+	} {
+		if !strings.Contains(shared, fragment) {
+			t.Errorf("Shared.php does not contain %q", fragment)
+		}
+	}
+
+	transactions := readGenerated(t, out, "Transactions/Transactions.php")
+	if !strings.Contains(transactions, "use SumUp\\Shared\\TransactionBaseInterface;\n") {
+		t.Errorf("Transactions.php does not import the shared TransactionBaseInterface")
+	}
+}
+
+func TestBuildAppliesConfig(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{
+		Namespace:      "Acme\\SumUp",
+		Layout:         Layout{Types: "Models"},
+		TypeMappings:   map[string]string{"int64": "string"},
+		SchemaNames:    map[string]string{"Checkout": "PaymentCheckout"},
+		OperationNames: map[string]string{"CreateCheckout": "startCheckout"},
+		IncludeTags:    []string{"checkouts", "Merchants"},
+	})
+
+	for _, dir := range []string{"Types", "Readers", "Transactions"} {
+		if _, err := os.Stat(filepath.Join(out, dir)); !os.IsNotExist(err) {
+			t.Errorf("%s directory exists: %v", dir, err)
+		}
+	}
+
+	checkout := readGenerated(t, out, "Models/PaymentCheckout.php")
+	for _, fragment := range []string{
+		"namespace Acme\\SumUp\\Models;\n",
+		"use Acme\\SumUp\\AdditionalPropertiesInterface;\n",
+		"class PaymentCheckout implements AdditionalPropertiesInterface\n",
+	} {
+		if !strings.Contains(checkout, fragment) {
+			t.Errorf("PaymentCheckout does not contain %q", fragment)
+		}
+	}
+
+	identifiers := readGenerated(t, out, "Models/ClassicMerchantIdentifiers.php")
+	if !strings.Contains(identifiers, "    public string $id;\n") {
+		t.Errorf("int64 id is not mapped to a string:\n%s", identifiers)
+	}
+
+	checkouts := readGenerated(t, out, "Checkouts/Checkouts.php")
+	for _, fragment := range []string{
+		"namespace Acme\\SumUp\\Services;\n",
+		"use Acme\\SumUp\\HttpClient\\RequestOptions;\n",
+		"use Acme\\SumUp\\Models\\PaymentCheckout;\n",
+		"    public function startCheckout(\n",
+	} {
+		if !strings.Contains(checkouts, fragment) {
+			t.Errorf("Checkouts.php does not contain %q", fragment)
+		}
+	}
+}
+
+func TestBuildFiltersOperations(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{})
+	for _, dir := range []string{"Members", "Memberships", "Roles"} {
+		if _, err := os.Stat(filepath.Join(out, dir)); !os.IsNotExist(err) {
+			t.Errorf("beta tag %s is generated without IncludeBeta: %v", dir, err)
+		}
+	}
+
+	out = testBuild(t, Config{
+		IncludeTags:       []string{"readers"},
+		IncludeOperations: []string{"CreateCheckout", "GetCheckout"},
+		ExcludeOperations: []string{"GetCheckout", "DeleteReader"},
+	})
+	for _, dir := range []string{"Customers", "Merchants", "Transactions"} {
+		if _, err := os.Stat(filepath.Join(out, dir)); !os.IsNotExist(err) {
+			t.Errorf("filtered out tag %s is generated: %v", dir, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(out, "Types", "TransactionFull.php")); !os.IsNotExist(err) {
+		t.Errorf("model of a filtered out operation is generated: %v", err)
+	}
+
+	checkouts := readGenerated(t, out, "Checkouts/Checkouts.php")
+	if !strings.Contains(checkouts, "public function create(") {
+		t.Errorf("included CreateCheckout operation is not generated")
+	}
+	if strings.Contains(checkouts, "public function get(") || strings.Contains(checkouts, "public function list(") {
+		t.Errorf("Checkouts.php contains filtered out operations:\n%s", checkouts)
+	}
+
+	readers := readGenerated(t, out, "Readers/Readers.php")
+	if !strings.Contains(readers, "public function create(") || strings.Contains(readers, "public function delete(") {
+		t.Errorf("Readers.php does not contain exactly the included operations")
+	}
+}
+
+func TestBuildPrunesStaleFiles(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{IncludeBeta: true})
+
+	handWritten := filepath.Join(out, "HttpClient", "CurlClient.php")
+	if err := os.MkdirAll(filepath.Dir(handWritten), os.ModePerm); err != nil {
+		t.Fatalf("create hand-written directory: %v", err)
+	}
+	if err := os.WriteFile(handWritten, []byte("<?php\n"), 0o644); err != nil {
+		t.Fatalf("write hand-written file: %v", err)
+	}
+	edited := filepath.Join(out, "Roles", "Roles.php")
+	if err := os.WriteFile(edited, []byte(readGenerated(t, out, "Roles/Roles.php")+"// edited\n"), 0o644); err != nil {
+		t.Fatalf("edit generated file: %v", err)
+	}
+
+	testBuild(t, Config{Out: out})
+
+	for _, name := range []string{"Members", "Memberships", filepath.Join("Types", "Member.php")} {
+		if _, err := os.Stat(filepath.Join(out, name)); !os.IsNotExist(err) {
+			t.Errorf("stale %s is not removed: %v", name, err)
+		}
+	}
+	for _, filename := range []string{handWritten, edited} {
+		if _, err := os.Stat(filename); err != nil {
+			t.Errorf("%s is removed: %v", filename, err)
+		}
+	}
+
+	manifest := readGenerated(t, out, manifestFilename)
+	if !strings.Contains(manifest, `"Types/Checkout.php": "`) {
+		t.Errorf("manifest does not list generated files:\n%s", manifest)
+	}
+	if strings.Contains(manifest, "Roles/Roles.php") || strings.Contains(manifest, "HttpClient") {
+		t.Errorf("manifest lists files the generation did not produce:\n%s", manifest)
+	}
+}
+
+func TestRehashManifestPrunesFormattedFiles(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{IncludeBeta: true})
+
+	// A formatter rewriting a generated file makes it look edited until the
+	// manifest is rehashed.
+	formatted := filepath.Join(out, "Members", "Members.php")
+	if err := os.WriteFile(formatted, []byte(readGenerated(t, out, "Members/Members.php")+"\n"), 0o644); err != nil {
+		t.Fatalf("format generated file: %v", err)
+	}
+	if err := New(Config{Out: out}).RehashManifest(); err != nil {
+		t.Fatalf("rehash manifest: %v", err)
+	}
+
+	testBuild(t, Config{Out: out})
+
+	if _, err := os.Stat(formatted); !os.IsNotExist(err) {
+		t.Errorf("stale formatted Members.php is not removed: %v", err)
+	}
+
+	if err := New(Config{Out: t.TempDir()}).RehashManifest(); err == nil {
+		t.Error("RehashManifest() succeeded without manifest, want an error")
+	}
+}
+
+func TestBuildCleansOutputWithoutManifest(t *testing.T) {
+	t.Parallel()
+
+	out := t.TempDir()
+	files := map[string]bool{
+		// Files of an older generation, which did not write a manifest.
+		"Types/Legacy.php":          false,
+		"Shared/Shared.php":         false,
+		"Checkouts/Legacy.php":      false,
+		"Services/Checkouts.php":    false,
+		"Types/Checkout.php":        true,
+		"HttpClient/CurlClient.php": true,
+		"Services/SumUpService.php": true,
+		"SumUp.php":                 true,
+	}
+	for name := range files {
+		filename := filepath.Join(out, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), os.ModePerm); err != nil {
+			t.Fatalf("create directory: %v", err)
+		}
+		if err := os.WriteFile(filename, []byte("<?php\n"), 0o644); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+	}
+
+	// Files missing from a manifest are only removed on request.
+	kept := t.TempDir()
+	if err := os.CopyFS(kept, os.DirFS(out)); err != nil {
+		t.Fatalf("copy output: %v", err)
+	}
+	testBuild(t, Config{Out: kept})
+	for name := range files {
+		if _, err := os.Stat(filepath.Join(kept, filepath.FromSlash(name))); err != nil {
+			t.Errorf("%s is removed without CleanWithoutManifest: %v", name, err)
+		}
+	}
+
+	testBuild(t, Config{Out: out, CleanWithoutManifest: true})
+
+	for name, kept := range files {
+		_, err := os.Stat(filepath.Join(out, filepath.FromSlash(name)))
+		if kept && err != nil {
+			t.Errorf("%s is removed: %v", name, err)
+		}
+		if !kept && !os.IsNotExist(err) {
+			t.Errorf("unlisted %s is not removed: %v", name, err)
+		}
+	}
+}
+
+func TestBuildWritesToMemoryOutput(t *testing.T) {
+	t.Parallel()
+
+	out := MemoryOutput{}
+	if err := testGenerator(t, Config{Output: out, IncludeBeta: true}).Build(); err != nil {
+		t.Fatalf("build SDK: %v", err)
+	}
+	for _, name := range []string{"ApiVersion.php", "Members/Members.php", "Types/Checkout.php", manifestFilename} {
+		if _, ok := out[name]; !ok {
+			t.Errorf("%s is not generated in memory", name)
+		}
+	}
+
+	if err := testGenerator(t, Config{Output: out}).Build(); err != nil {
+		t.Fatalf("build SDK: %v", err)
+	}
+	if _, ok := out["Members/Members.php"]; ok {
+		t.Errorf("stale Members/Members.php is not removed from memory")
+	}
+}
+
+func TestCheckReportsChangedFiles(t *testing.T) {
+	t.Parallel()
+
+	out := testBuild(t, Config{IncludeBeta: true})
+
+	g := testGenerator(t, Config{Out: out, IncludeBeta: true})
+	changes, err := g.Check()
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("Check() = %d changes right after Build, want none", len(changes))
+	}
+
+	edited := readGenerated(t, out, "Readers/Readers.php") + "// edited\n"
+	if err := os.WriteFile(filepath.Join(out, "Readers", "Readers.php"), []byte(edited), 0o644); err != nil {
+		t.Fatalf("edit generated file: %v", err)
+	}
+	if err := os.Remove(filepath.Join(out, "ApiVersion.php")); err != nil {
+		t.Fatalf("remove generated file: %v", err)
+	}
+
+	g = testGenerator(t, Config{Out: out})
+	changes, err = g.Check()
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	got := make(map[string]FileChange)
+	for _, change := range changes {
+		got[change.Name] = change
+	}
+	if change := got["ApiVersion.php"]; change.Old != nil || change.New == nil {
+		t.Errorf("removed ApiVersion.php is not reported as added: %+v", change)
+	}
+	if change := got["Readers/Readers.php"]; string(change.Old) != edited || change.New == nil {
+		t.Errorf("edited Readers.php is not reported as modified")
+	}
+	if change := got["Members/Members.php"]; change.Old == nil || change.New != nil {
+		t.Errorf("filtered out Members.php is not reported as removed")
+	}
+
+	if _, err := os.Stat(filepath.Join(out, "Members", "Members.php")); err != nil {
+		t.Errorf("Check removed a file: %v", err)
+	}
+	if readGenerated(t, out, "Readers/Readers.php") != edited {
+		t.Errorf("Check wrote a file")
+	}
+}
+
+func TestSurfaceDescribesPublicAPI(t *testing.T) {
+	t.Parallel()
+
+	out := t.TempDir()
+	api, err := testGenerator(t, Config{Out: out, IncludeBeta: true}).Surface()
+	if err != nil {
+		t.Fatalf("surface: %v", err)
+	}
+	if entries, _ := os.ReadDir(out); len(entries) != 0 {
+		t.Errorf("Surface wrote %d entries to the output directory", len(entries))
+	}
+
+	services := make(map[string]surface.Class)
+	for _, service := range api.Services {
+		services[service.Name] = service
+	}
+	checkouts, ok := services[`SumUp\Services\Checkouts`]
+	if !ok {
+		t.Fatalf("Checkouts service is missing from %d services", len(api.Services))
+	}
+	var create surface.Method
+	for _, method := range checkouts.Methods {
+		if method.Name == "create" {
+			create = method
+		}
+	}
+	wantCreate := surface.Method{
+		Name: "create",
+		Params: []surface.Param{
+			{Name: "body", Type: `SumUp\Types\CheckoutCreateRequest|array`, DocType: `SumUp\Types\CheckoutCreateRequest|array<string, mixed>`},
+			{Name: "requestOptions", Type: `?SumUp\HttpClient\RequestOptions`, Optional: true},
+		},
+		ReturnType: `SumUp\Types\Checkout`,
+	}
+	if !reflect.DeepEqual(create, wantCreate) {
+		t.Errorf("Checkouts::create() = %+v, want %+v", create, wantCreate)
+	}
+
+	for _, class := range api.Classes {
+		if class.Name != `SumUp\Types\Member` {
+			continue
+		}
+		for _, property := range class.Properties {
+			if property.Name == "permissions" && (!property.Deprecated || property.DocType != "string[]") {
+				t.Errorf("Member::$permissions = %+v, want a deprecated string[]", property)
+			}
+		}
+	}
+
+	for _, enum := range api.Enums {
+		if enum.Name == `SumUp\Types\CheckoutStatus` && (enum.BackingType != "string" || len(enum.Cases) == 0) {
+			t.Errorf("CheckoutStatus = %+v, want backed string cases", enum)
+		}
+	}
+}
+
+func TestReadConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "codegen.yaml")
+	contents := `out: build/src
+namespace: \Acme\SumUp\
+layout:
+  types: Models
+type_mappings:
+  decimal: string
+schema_names:
+  Checkout: PaymentCheckout
+operation_names:
+  CreateCheckout: startCheckout
+include_tags: [Checkouts]
+features:
+  php_version: "8.1"
+  model_placement: tag
+`
+	if err := os.WriteFile(filename, []byte(contents), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	cfg, err := ReadConfig(filename)
+	if err != nil {
+		t.Fatalf("ReadConfig() error = %v", err)
+	}
+	if cfg.Out != filepath.Join(dir, "build", "src") {
+		t.Errorf("Out = %q, want it relative to the config file", cfg.Out)
+	}
+	if cfg.Namespace != "Acme\\SumUp" {
+		t.Errorf("Namespace = %q, want Acme\\SumUp", cfg.Namespace)
+	}
+	if cfg.Layout.Types != "Models" || cfg.TypeMappings["decimal"] != "string" || cfg.SchemaNames["Checkout"] != "PaymentCheckout" {
+		t.Errorf("ReadConfig() = %+v", cfg)
+	}
+	if cfg.PHPVersion != "8.1" || cfg.ModelPlacement != ModelPlacementTag || len(cfg.IncludeTags) != 1 {
+		t.Errorf("ReadConfig() features = %+v", cfg)
+	}
+
+	for name, contents := range map[string]string{
+		"unknown.yaml": "namespaces: Acme\n",
+		"mapping.yaml": "type_mappings:\n  date-time: \\DateTimeImmutable\n",
+		"name.yaml":    "schema_names:\n  Checkout: Payment-Checkout\n",
+	} {
+		filename := filepath.Join(dir, name)
+		if err := os.WriteFile(filename, []byte(contents), 0o644); err != nil {
+			t.Fatalf("write config: %v", err)
+		}
+		if _, err := ReadConfig(filename); err == nil {
+			t.Errorf("ReadConfig(%s) succeeded, want an error", name)
+		}
+	}
+}
+
+func TestLoadRejectsUnsupportedPHPVersions(t *testing.T) {
+	t.Parallel()
+
+	for _, cfg := range []Config{
+		{PHPVersion: "7.3"},
+		{PHPVersion: "eight"},
+		{PHPVersion: "8.0", ReadonlyResponses: true},
+	} {
+		if err := New(cfg).Load(&v3.Document{}); err == nil {
+			t.Errorf("Load(%+v) succeeded, want an error", cfg)
+		}
+	}
+}
+
+func TestLoadRejectsUnknownModelPlacements(t *testing.T) {
+	t.Parallel()
+
+	err := New(Config{ModelPlacement: "flat"}).Load(&v3.Document{})
+	if err == nil || !strings.Contains(err.Error(), `invalid model placement "flat"`) {
+		t.Errorf("Load() error = %v, want an invalid model placement", err)
+	}
+
+	for _, placement := range []ModelPlacement{"", ModelPlacementTypes, ModelPlacementTag} {
+		if err := New(Config{ModelPlacement: placement}).Load(&v3.Document{}); err != nil {
+			t.Errorf("Load() with placement %q: %v", placement, err)
+		}
+	}
+}
+
+func TestLoadRejectsInvalidNamespaces(t *testing.T) {
+	t.Parallel()
+
+	for _, cfg := range []Config{
+		{Namespace: "Acme\\"},
+		{Layout: Layout{Types: "My Types"}},
+	} {
+		if err := New(cfg).Load(&v3.Document{}); err == nil {
+			t.Errorf("Load(%+v) succeeded, want an error", cfg)
+		}
+	}
+}
+
+func TestBuildTypesAdditionalPropertiesMaps(t *testing.T) {
+	t.Parallel()
+
+	out := testBuildSpec(t, []byte(`
+openapi: 3.0.3
+info:
+  title: Maps
+  version: 1.0.0
+paths:
+  /links:
+    get:
+      operationId: ListLinks
+      tags: [Links]
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/LinkIndex"
+components:
+  schemas:
+    Link:
+      type: object
+      properties:
+        href:
+          type: string
+    Labels:
+      type: object
+      additionalProperties:
+        type: string
+    LinkIndex:
+      type: object
+      properties:
+        by_rel:
+          type: object
+          additionalProperties:
+            $ref: "#/components/schemas/Link"
+        counts:
+          type: object
+          additionalProperties:
+            type: integer
+        extra:
+          type: object
+          additionalProperties: true
+        labels:
+          $ref: "#/components/schemas/Labels"
+`), Config{})
+
+	index := readGenerated(t, out, "Types/LinkIndex.php")
+	for _, fragment := range []string{
+		"@var array<string, Link>|null",
+		"@var array<string, int>|null",
+		"@var array<string, mixed>|null",
+		"public ?Labels $labels = null;",
+	} {
+		if !strings.Contains(index, fragment) {
+			t.Errorf("LinkIndex does not contain %q:\n%s", fragment, index)
+		}
+	}
+
+	labels := readGenerated(t, out, "Types/Labels.php")
+	for _, fragment := range []string{
+		"@extends \\ArrayObject<string, string>",
+		"class Labels extends \\ArrayObject",
+		"public const VALUE_TYPE = 'string';",
+	} {
+		if !strings.Contains(labels, fragment) {
+			t.Errorf("Labels does not contain %q:\n%s", fragment, labels)
+		}
+	}
+}
+
+func TestBuildRendersSchemaDefaultsAndConstants(t *testing.T) {
+	t.Parallel()
+
+	out := testBuildSpec(t, []byte(`
+openapi: 3.1.0
+info:
+  title: Defaults
+  version: 1.0.0
+paths:
+  /events:
+    get:
+      operationId: ListEvents
+      tags: [Events]
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            default: 10
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Event"
+    post:
+      operationId: CreateEvent
+      tags: [Events]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/EventRequest"
+      responses:
+        "201":
+          description: Created
+components:
+  schemas:
+    EventRequest:
+      type: object
+      properties:
+        name:
+          type: string
+        weight:
+          type: number
+          default: 1
+    Event:
+      type: object
+      required: [kind]
+      properties:
+        kind:
+          type: string
+          const: event
+        status:
+          type: string
+          enum: [ACTIVE, INACTIVE]
+          default: ACTIVE
+        retries:
+          type: integer
+          default: 3
+        tags:
+          type: array
+          items:
+            type: string
+          default: [a]
+        metadata:
+          type: object
+          properties:
+            source:
+              type: string
+          default: {}
+`), Config{})
+
+	event := readGenerated(t, out, "Types/Event.php")
+	for _, fragment := range []string{
+		"public const KIND = 'event';",
+		"public string $kind = self::KIND;",
+		"public ?EventStatus $status = EventStatus::ACTIVE;",
+		"public ?int $retries = 3;",
+		"public ?EventMetadata $metadata = null;",
+	} {
+		if !strings.Contains(event, fragment) {
+			t.Errorf("Event does not contain %q:\n%s", fragment, event)
+		}
+	}
+
+	events := readGenerated(t, out, "Events/Events.php")
+	for _, fragment := range []string{
+		"public ?int $limit = 10;",
+		// A parameter left to its default is not sent.
+		"if (isset($queryParams->limit) && $queryParams->limit !== 10) {",
+	} {
+		if !strings.Contains(events, fragment) {
+			t.Errorf("Events does not contain %q:\n%s", fragment, events)
+		}
+	}
+
+	// Request payloads send a default only when it is changed or set through
+	// fromArray().
+	request := readGenerated(t, out, "Types/EventRequest.php")
+	for _, fragment := range []string{
+		"public ?float $weight = 1;",
+		"$request->markExplicitFields($data);",
+		"if (isset($this->weight) && ($this->weight !== 1.0 || isset($this->explicitFields['weight']))) {",
+		"if (isset($this->name)) {",
+		"'weight' => 'weight',",
+	} {
+		if !strings.Contains(request, fragment) {
+			t.Errorf("EventRequest does not contain %q:\n%s", fragment, request)
+		}
+	}
+	if strings.Contains(request, "'name' => 'name',") || strings.Contains(request, "ExplicitFieldsInterface") {
+		t.Errorf("EventRequest tracks the fields without default:\n%s", request)
+	}
+	if strings.Contains(event, "explicitFields") {
+		t.Errorf("response class tracks explicit fields:\n%s", event)
+	}
+}
+
+func TestBuildEscapesSpecValues(t *testing.T) {
+	t.Parallel()
+
+	out := testBuildSpec(t, []byte(`
+openapi: 3.0.3
+info:
+  title: Escaping
+  version: 1.0.0
+paths:
+  /notes:
+    get:
+      operationId: ListNotes
+      tags: [Notes]
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Note"
+components:
+  schemas:
+    Note:
+      type: object
+      description: Notes end with */ at times.
+      properties:
+        mood:
+          type: string
+          description: Either "it's" or "ok" */
+          enum: ["it's", ok]
+`), Config{})
+
+	note := readGenerated(t, out, "Types/Note.php")
+	if strings.Count(note, "*/") != strings.Count(note, "/**") {
+		t.Errorf("Note closes a docblock early:\n%s", note)
+	}
+	if !strings.Contains(note, " * Notes end with *\\/ at times.\n") {
+		t.Errorf("Note does not escape its description:\n%s", note)
+	}
+
+	mood := readGenerated(t, out, "Types/NoteMood.php")
+	if !strings.Contains(mood, "    case IT_S = 'it\\'s';\n") {
+		t.Errorf("NoteMood does not escape its values:\n%s", mood)
+	}
+}
+
+func TestBuildSurfacesLifecycle(t *testing.T) {
+	t.Parallel()
+
+	out := testBuildSpec(t, []byte(`
+openapi: 3.0.3
+info:
+  title: Lifecycle
+  version: 1.0.0
+paths:
+  /notes:
+    post:
+      operationId: CreateNote
+      tags: [Notes]
+      deprecated: true
+      x-deprecation-notice: Use the v2 endpoint instead.
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/Note"
+      responses:
+        "204":
+          description: Created
+  /notes/{id}:
+    get:
+      operationId: GetNote
+      tags: [Notes]
+      x-beta: true
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Note"
+components:
+  schemas:
+    Note:
+      type: object
+      properties:
+        text:
+          type: string
+        legacy:
+          type: boolean
+          x-deprecation-notice: Rely on text instead.
+`), Config{IncludeBeta: true, TriggerDeprecations: true})
+
+	notes := readGenerated(t, out, "Notes/Notes.php")
+	for _, fragment := range []string{
+		"     * @deprecated Use the v2 endpoint instead.\n",
+		"     * @experimental\n",
+		"@trigger_error(self::class . '::createNote() is deprecated. Use the v2 endpoint instead.', E_USER_DEPRECATED);",
+	} {
+		if !strings.Contains(notes, fragment) {
+			t.Errorf("Notes.php does not contain %q:\n%s", fragment, notes)
+		}
+	}
+
+	note := readGenerated(t, out, "Types/Note.php")
+	for _, fragment := range []string{
+		"     * @deprecated Rely on text instead.\n",
+		"if ($legacy !== null) {\n",
+		"@trigger_error(self::class . '::$legacy is deprecated. Rely on text instead.', E_USER_DEPRECATED);",
+	} {
+		if !strings.Contains(note, fragment) {
+			t.Errorf("Note.php does not contain %q:\n%s", fragment, note)
+		}
+	}
+}
+
+func TestLintReportsConventionViolations(t *testing.T) {
+	t.Parallel()
+
+	g := testGeneratorSpec(t, []byte(`
+openapi: 3.0.3
+info:
+  title: Lint
+  version: 1.0.0
+tags:
+  - name: Orders
+paths:
+  /orders:
+    get:
+      operationId: ListOrders
+      tags: [Orders]
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: "#/components/schemas/Order"
+    post:
+      tags: [Orders]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/List"
+      responses:
+        "204":
+          description: Created
+  /orders/search:
+    get:
+      operationId: SearchOrders
+      tags: [Orders]
+      x-codegen:
+        method_name: listOrders
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/OrderStatus"
+  /health:
+    get:
+      operationId: GetHealth
+      responses:
+        "204":
+          description: OK
+components:
+  schemas:
+    Order:
+      type: object
+      properties:
+        status:
+          type: string
+          enum: [pending, paid]
+    OrderStatus:
+      type: object
+      properties:
+        changed_at:
+          type: string
+    List:
+      type: object
+      properties:
+        name:
+          type: string
+`), Config{})
+
+	got := g.Lint()
+	want := []Problem{
+		{Severity: SeverityWarning, Pointer: "/components/schemas/List", Message: "class List is named after a PHP reserved word, it is generated as ListModel"},
+		{Severity: SeverityWarning, Pointer: "/components/schemas/OrderStatus", Message: "class OrderStatus collides with the enum generated for /components/schemas/Order/properties/status, one of them is renamed"},
+		{Severity: SeverityError, Pointer: "/paths/~1health/get", Message: "missing tags, the operation is not generated"},
+		{Severity: SeverityError, Pointer: "/paths/~1orders/post", Message: "missing operationId"},
+		{Severity: SeverityError, Pointer: "/paths/~1orders~1search/get", Message: `method listOrders of tag "Orders" is already generated for /paths/~1orders/get`},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lint() = %#v, want %#v", got, want)
+	}
+}
+
+func TestBuildResolvesClassNameCollisions(t *testing.T) {
+	t.Parallel()
+
+	out := testBuildSpec(t, []byte(`
+openapi: 3.0.3
+info:
+  title: Names
+  version: 1.0.0
+paths:
+  /orders:
+    post:
+      operationId: CreateOrder
+      tags: [Orders]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/Order"
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/List"
+components:
+  schemas:
+    Order:
+      type: object
+      properties:
+        status:
+          type: string
+          enum: [pending, paid]
+        customer:
+          type: object
+          properties:
+            name:
+              type: string
+        history:
+          $ref: "#/components/schemas/OrderStatus"
+        item:
+          $ref: "#/components/schemas/order_item"
+    OrderStatus:
+      type: object
+      properties:
+        changed_at:
+          type: string
+    OrderCustomer:
+      type: object
+      properties:
+        id:
+          type: string
+    order_item:
+      type: object
+      x-codegen:
+        class_name: LineItem
+      properties:
+        sku:
+          type: string
+    List:
+      type: object
+      properties:
+        orders:
+          type: array
+          items:
+            $ref: "#/components/schemas/OrderCustomer"
+`), Config{})
+
+	for name, declaration := range map[string]string{
+		"Types/ListModel.php":      "class ListModel",
+		"Types/OrderStatus.php":    "class OrderStatus",
+		"Types/OrderStatus2.php":   "enum OrderStatus2: string",
+		"Types/OrderCustomer.php":  "class OrderCustomer",
+		"Types/OrderCustomer2.php": "class OrderCustomer2",
+		"Types/LineItem.php":       "class LineItem",
+	} {
+		if contents := readGenerated(t, out, name); !strings.Contains(contents, declaration) {
+			t.Errorf("%s does not declare %q:\n%s", name, declaration, contents)
+		}
+	}
+
+	order := readGenerated(t, out, "Types/Order.php")
+	for _, fragment := range []string{
+		"public ?OrderStatus2 $status = null;",
+		"public ?OrderCustomer2 $customer = null;",
+		"public ?OrderStatus $history = null;",
+		"public ?LineItem $item = null;",
+	} {
+		if !strings.Contains(order, fragment) {
+			t.Errorf("Order.php does not contain %q:\n%s", fragment, order)
+		}
+	}
+}
+
+func TestBuildResolvesInterfaceAndServiceNameCollisions(t *testing.T) {
+	t.Parallel()
+
+	spec := []byte(`
+openapi: 3.0.3
+info:
+  title: Names
+  version: 1.0.0
+paths:
+  /orders:
+    get:
+      operationId: ListOrders
+      tags: [Orders]
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Orders"
+  /payments:
+    get:
+      operationId: GetPayment
+      tags: [Payments]
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/PaymentInterface"
+components:
+  schemas:
+    Orders:
+      type: object
+      properties:
+        items:
+          type: array
+          items:
+            $ref: "#/components/schemas/Order"
+    Order:
+      allOf:
+        - $ref: "#/components/schemas/Payment"
+        - $ref: "#/components/schemas/Refund"
+    Payment:
+      type: object
+      properties:
+        amount:
+          type: number
+    Refund:
+      type: object
+      properties:
+        reason:
+          type: string
+    PaymentInterface:
+      type: object
+      properties:
+        id:
+          type: string
+`)
+
+	out := testBuildSpec(t, spec, Config{})
+	for name, declaration := range map[string]string{
+		"Types/Orders.php":            "class Orders",
+		"Types/PaymentInterface.php":  "class PaymentInterface",
+		"Types/PaymentInterface2.php": "interface PaymentInterface2",
+		"Types/Payment.php":           "class Payment implements PaymentInterface2",
+		"Types/Order.php":             "implements PaymentInterface2, RefundInterface",
+	} {
+		if contents := readGenerated(t, out, name); !strings.Contains(contents, declaration) {
+			t.Errorf("%s does not declare %q:\n%s", name, declaration, contents)
+		}
+	}
+
+	out = testBuildSpec(t, spec, Config{ModelPlacement: ModelPlacementTag})
+	orders := readGenerated(t, out, "Orders/Orders.php")
+	for _, fragment := range []string{
+		"namespace SumUp\\Orders;",
+		"class Orders2 implements",
+		"use SumUp\\Orders\\Orders2;",
+		"class Orders implements SumUpService",
+	} {
+		if !strings.Contains(orders, fragment) {
+			t.Errorf("Orders.php does not contain %q:\n%s", fragment, orders)
+		}
+	}
+}
+
+func TestBuildReportsDowngrades(t *testing.T) {
+	t.Parallel()
+
+	g := testGeneratorSpec(t, []byte(`
+openapi: 3.0.3
+info:
+  title: Downgrades
+  version: 1.0.0
+paths:
+  /notes:
+    post:
+      operationId: CreateNote
+      tags: [Notes]
+      parameters:
+        - name: Idempotency-Key
+          in: header
+          schema:
+            type: string
+      requestBody:
+        content:
+          text/plain:
+            schema:
+              type: string
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Note"
+        "202":
+          description: Accepted
+          content:
+            text/csv:
+              schema:
+                type: string
+  /health:
+    get:
+      operationId: GetHealth
+      responses:
+        "204":
+          description: OK
+components:
+  schemas:
+    Note:
+      type: object
+      properties:
+        amount:
+          type: number
+          format: decimal
+        body:
+          oneOf:
+            - type: string
+            - type: integer
+`), Config{Output: MemoryOutput{}})
+	if err := g.Build(); err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	report := g.Report()
+
+	if !slices.Contains(report.Services, `SumUp\Services\Notes`) {
+		t.Errorf("report services = %v, want the Notes service", report.Services)
+	}
+	if !slices.Contains(report.Classes, `SumUp\Types\Note`) {
+		t.Errorf("report classes = %v, want Note", report.Classes)
+	}
+	if !slices.Contains(report.Files, "Types/Note.php") {
+		t.Errorf("report files = %v, want Types/Note.php", report.Files)
+	}
+
+	got := make([]string, 0, len(report.Downgrades))
+	for _, downgrade := range report.Downgrades {
+		if downgrade.Line == 0 {
+			t.Errorf("downgrade %q has no source location", downgrade.Message)
+		}
+		got = append(got, fmt.Sprintf("%s %s %s", downgrade.Kind, downgrade.Pointer, downgrade.Message))
+	}
+	want := []string{
+		`parameter /paths/~1notes/post header parameter "Idempotency-Key" is not accepted by the service method`,
+		"non_json_body /paths/~1notes/post request body of media type text/plain is encoded as JSON",
+		"non_json_body /paths/~1notes/post 202 response body of media type text/csv is ignored",
+		"untagged_operation /paths/~1health/get operation GetHealth has no tags, no service generates it",
+		`unsupported_format /components/schemas/Note/properties/amount format "decimal" is generated as float`,
+		"composition /components/schemas/Note/properties/body oneOf schema is generated as mixed",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("downgrades = %q, want %q", got, want)
+	}
+}
+
+func TestBuildGeneratesSameSDKFromOpenAPI31(t *testing.T) {
+	t.Parallel()
+
+	spec30 := []byte(`
+openapi: 3.0.3
+info:
+  title: Notes
+  version: 1.0.0
+paths:
+  /notes:
+    post:
+      operationId: CreateNote
+      tags: [Notes]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/Note"
+      responses:
+        "201":
+          description: Created
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Note"
+components:
+  schemas:
+    Author:
+      type: object
+      properties:
+        name:
+          type: string
+          example: Ada
+    Note:
+      type: object
+      required: [title, status, author]
+      properties:
+        title:
+          type: string
+          nullable: true
+          example: Groceries
+        status:
+          type: string
+          nullable: true
+          enum: [draft, published, null]
+        author:
+          $ref: "#/components/schemas/Author"
+        editor:
+          nullable: true
+          allOf:
+            - $ref: "#/components/schemas/Author"
+        attachment:
+          type: string
+          format: byte
+        binary:
+          type: string
+          format: binary
+`)
+	spec31 := []byte(`
+openapi: 3.1.0
+info:
+  title: Notes
+  version: 1.0.0
+paths:
+  /notes:
+    post:
+      operationId: CreateNote
+      tags: [Notes]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/Note"
+      responses:
+        "201":
+          description: Created
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Note"
+components:
+  schemas:
+    Author:
+      type: object
+      properties:
+        name:
+          type: string
+          examples: [Ada]
+    Note:
+      type: object
+      required: [title, status, author]
+      properties:
+        title:
+          type: [string, "null"]
+          examples: [Groceries]
+        status:
+          type: [string, "null"]
+          enum: [draft, published, null]
+        author:
+          $ref: "#/components/schemas/Author"
+          description: Author of the note.
+        editor:
+          anyOf:
+            - $ref: "#/components/schemas/Author"
+            - type: "null"
+        attachment:
+          type: string
+          contentEncoding: base64
+        binary:
+          type: string
+          contentMediaType: application/octet-stream
+`)
+
+	build := func(spec []byte) (MemoryOutput, Report, []Sample) {
+		out := MemoryOutput{}
+		g := testGeneratorSpec(t, spec, Config{Output: out})
+		if err := g.Build(); err != nil {
+			t.Fatalf("build SDK: %v", err)
+		}
+		catalog, err := g.Samples("test")
+		if err != nil {
+			t.Fatalf("generate samples: %v", err)
+		}
+		return out, g.Report(), catalog.Samples
+	}
+	out30, report30, samples30 := build(spec30)
+	out31, report31, samples31 := build(spec31)
+
+	if !slices.Equal(slices.Sorted(maps.Keys(out30)), slices.Sorted(maps.Keys(out31))) {
+		t.Fatalf("generated files differ:\n3.0: %v\n3.1: %v", slices.Sorted(maps.Keys(out30)), slices.Sorted(maps.Keys(out31)))
+	}
+	for name, source := range out30 {
+		if string(source) != string(out31[name]) {
+			t.Errorf("%s differs:\n3.0:\n%s\n3.1:\n%s", name, source, out31[name])
+		}
+	}
+	if len(report30.Downgrades) != 1 || len(report31.Downgrades) != 1 || report30.Downgrades[0].Message != report31.Downgrades[0].Message {
+		t.Errorf("downgrades differ:\n3.0: %v\n3.1: %v", report30.Downgrades, report31.Downgrades)
+	}
+	if !reflect.DeepEqual(samples30, samples31) {
+		t.Errorf("samples differ:\n3.0: %v\n3.1: %v", samples30, samples31)
+	}
+
+	note := generatedClass(t, string(out31["Types/Note.php"]), "Note")
+	for _, want := range []string{
+		"public ?string $title;",
+		"public ?NoteStatus $status;",
+		"public Author $author;",
+		"public ?Author $editor = null;",
+		"$value instanceof Author || $value === null ? $value : Author::fromArray($value)",
+	} {
+		if !strings.Contains(note, want) {
+			t.Errorf("Note does not contain %q:\n%s", want, note)
+		}
+	}
+	if enum := string(out31["Types/NoteStatus.php"]); strings.Contains(enum, "case NULL") {
+		t.Errorf("NoteStatus has a case for null:\n%s", enum)
+	}
+	if !strings.Contains(samples31[0].Source, "'title' => 'Groceries'") {
+		t.Errorf("sample does not use the examples of the schema:\n%s", samples31[0].Source)
+	}
+}
+
+func TestBuildRendersOpenAPI31Keywords(t *testing.T) {
+	t.Parallel()
+
+	out := MemoryOutput{}
+	g := testGeneratorSpec(t, []byte(`
+openapi: 3.1.0
+info:
+  title: Shapes
+  version: 1.0.0
+paths:
+  /shapes:
+    get:
+      operationId: GetShape
+      tags: [Shapes]
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Shape"
+components:
+  schemas:
+    Shape:
+      type: object
+      properties:
+        kind:
+          const: point
+        dimensions:
+          const: 2
+        position:
+          type: array
+          prefixItems:
+            - type: number
+            - type: number
+            - type: string
+`), Config{Output: out})
+	if err := g.Build(); err != nil {
+		t.Fatalf("build SDK: %v", err)
+	}
+
+	shape := generatedClass(t, string(out["Types/Shape.php"]), "Shape")
+	for _, want := range []string{
+		"public const KIND = 'point';",
+		"public ?string $kind = self::KIND;",
+		"public const DIMENSIONS = 2;",
+		"public ?int $dimensions = self::DIMENSIONS;",
+		"@var array{float, float, string}|null",
+		"public ?array $position = null;",
+	} {
+		if !strings.Contains(shape, want) {
+			t.Errorf("Shape does not contain %q:\n%s", want, shape)
+		}
+	}
+}
+
+func TestReadSpecsResolvesFilesAndMergesDocuments(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeSpec := func(name, contents string) string {
+		t.Helper()
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		return path
+	}
+	writeSpec("common.yaml", `
+openapi: 3.0.3
+info: {title: Common, version: 1.0.0}
+paths: {}
+components:
+  schemas:
+    Author:
+      type: object
+      properties:
+        name: {type: string}
+`)
+	writeSpec("schemas/Note.yaml", `
+type: object
+properties:
+  title: {type: string}
+  author:
+    $ref: "../common.yaml#/components/schemas/Author"
+`)
+	notes := writeSpec("notes.yaml", `
+openapi: 3.0.3
+info: {title: Notes, version: 2.0.0}
+tags:
+  - name: Notes
+paths:
+  /notes:
+    get:
+      operationId: ListNotes
+      tags: [Notes]
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: "./schemas/Note.yaml"
+`)
+	authors := writeSpec("authors.json", `{
+  "openapi": "3.0.3",
+  "info": {"title": "Authors", "version": "1.0.0"},
+  "tags": [{"name": "Notes"}, {"name": "Authors"}],
+  "paths": {
+    "/authors": {
+      "get": {
+        "operationId": "ListAuthors",
+        "tags": ["Authors"],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Author"}}}
+          }
+        }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Author": {"type": "object", "properties": {"name": {"type": "string"}}}
+    }
+  }
+}`)
+
+	spec, err := ReadSpecs(notes, authors)
+	if err != nil {
+		t.Fatalf("read specs: %v", err)
+	}
+	model, err := ParseSpecs(spec)
+	if err != nil {
+		t.Fatalf("parse specs: %v", err)
+	}
+	if model.Info.Title != "Notes" {
+		t.Errorf("info is taken from %q, want the first document", model.Info.Title)
+	}
+	if tags := len(model.Tags); tags != 2 {
+		t.Errorf("merged specs have %d tags, want 2", tags)
+	}
+
+	out := MemoryOutput{}
+	g := New(Config{Output: out})
+	if err := g.Load(model); err != nil {
+		t.Fatalf("load generator: %v", err)
+	}
+	if err := g.Build(); err != nil {
+		t.Fatalf("build SDK: %v", err)
+	}
+	report := g.Report()
+	for _, want := range []string{`SumUp\Types\Author`, `SumUp\Types\Note`} {
+		if !slices.Contains(report.Classes, want) {
+			t.Errorf("class %s is not generated, got %v", want, report.Classes)
+		}
+	}
+	for _, want := range []string{`SumUp\Services\Authors`, `SumUp\Services\Notes`} {
+		if !slices.Contains(report.Services, want) {
+			t.Errorf("service %s is not generated, got %v", want, report.Services)
+		}
+	}
+
+	conflicting := writeSpec("conflicting.yaml", `
+openapi: 3.0.3
+info: {title: Conflicting, version: 1.0.0}
+paths: {}
+components:
+  schemas:
+    Author:
+      type: object
+      properties:
+        email: {type: string}
+`)
+	if _, err := ReadSpecs(authors, conflicting); err == nil || !strings.Contains(err.Error(), "/components/schemas/Author") {
+		t.Errorf("conflicting components are merged, got error %v", err)
+	}
+	if _, err := ReadSpecs(authors, filepath.Dir(authors)); err == nil || !strings.Contains(err.Error(), "use --out") {
+		t.Errorf("a directory is read as specs, got error %v", err)
+	}
+}
+
+func TestApplyOverlaysPatchesSpecs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "openapi.yaml")
+	if err := os.WriteFile(specPath, []byte(`
+openapi: 3.0.3
+info: {title: Notes, version: 1.0.0}
+paths:
+  /notes:
+    get:
+      operationId: ListNotes
+      summary: List notes
+      tags: [Notes]
+      responses:
+        "204":
+          description: OK
+  /internal/notes:
+    delete:
+      operationId: PurgeNotes
+      tags: [Notes]
+      responses:
+        "204":
+          description: OK
+`), 0o644); err != nil {
+		t.Fatalf("write specs: %v", err)
+	}
+	overlays := []string{filepath.Join(dir, "names.yaml"), filepath.Join(dir, "hide.yaml")}
+	if err := os.WriteFile(overlays[0], []byte(`
+overlay: 1.0.0
+info: {title: Names, version: 1.0.0}
+actions:
+  - target: $.paths['/notes'].get
+    update:
+      summary: List the notes of the merchant
+      x-codegen:
+        method_name: all
+  - target: $.paths['/missing'].get
+    update:
+      summary: Ignored
+`), 0o644); err != nil {
+		t.Fatalf("write overlay: %v", err)
+	}
+	if err := os.WriteFile(overlays[1], []byte(`
+overlay: 1.0.0
+info: {title: Hide, version: 1.0.0}
+actions:
+  - target: $.paths['/internal/notes']
+    remove: true
+`), 0o644); err != nil {
+		t.Fatalf("write overlay: %v", err)
+	}
+
+	spec, err := ReadSpecs(specPath)
+	if err != nil {
+		t.Fatalf("read specs: %v", err)
+	}
+	spec, err = ApplyOverlays(spec, overlays...)
+	if err != nil {
+		t.Fatalf("apply overlays: %v", err)
+	}
+	model, err := ParseSpecs(spec)
+	if err != nil {
+		t.Fatalf("parse specs: %v", err)
+	}
+
+	out := MemoryOutput{}
+	g := New(Config{Output: out})
+	if err := g.Load(model); err != nil {
+		t.Fatalf("load generator: %v", err)
+	}
+	if err := g.Build(); err != nil {
+		t.Fatalf("build SDK: %v", err)
+	}
+	service := string(out["Notes/Notes.php"])
+	for _, want := range []string{"List the notes of the merchant", "public function all("} {
+		if !strings.Contains(service, want) {
+			t.Errorf("service does not contain %q:\n%s", want, service)
+		}
+	}
+	if strings.Contains(service, "purge") {
+		t.Errorf("removed operation is generated:\n%s", service)
+	}
+
+	if _, err := ApplyOverlays(spec, specPath); err == nil {
+		t.Errorf("specs are applied as an overlay")
+	}
+}
+
+func TestBuildRunsHooks(t *testing.T) {
+	t.Parallel()
+
+	out := MemoryOutput{}
+	hook := &testHook{}
+	if err := testGenerator(t, Config{Output: out, Hooks: []Hook{hook}}).Build(); err != nil {
+		t.Fatalf("build SDK: %v", err)
+	}
+
+	checkout := string(out["Types/Checkout.php"])
+	if !strings.Contains(checkout, " * @schema object\n") {
+		t.Errorf("schema class is not annotated:\n%s", checkout)
+	}
+	service := generatedClass(t, string(out["Checkouts/Checkouts.php"]), "Checkouts")
+	if !strings.Contains(service, "@operation CreateCheckout POST /v0.1/checkouts\n") {
+		t.Errorf("service method is not annotated:\n%s", service)
+	}
+	if strings.Contains(service, "@schema") {
+		t.Errorf("service is annotated as a schema class:\n%s", service)
+	}
+	if got := string(out["docs/Checkouts.md"]); !strings.Contains(got, "# SumUp\\Services\\Checkouts\n") {
+		t.Errorf("service file is not emitted:\n%s", got)
+	}
+	if got := string(out["docs/index.md"]); got != fmt.Sprintf("%d services\n", hook.services) || hook.services == 0 {
+		t.Errorf("index = %q, want %d services", got, hook.services)
+	}
+	manifest := string(out[manifestFilename])
+	if !strings.Contains(manifest, `"docs/Checkouts.md"`) || !strings.Contains(manifest, `"docs/index.md"`) {
+		t.Errorf("manifest does not list emitted files:\n%s", manifest)
+	}
+
+	if err := testGenerator(t, Config{Output: out}).Build(); err != nil {
+		t.Fatalf("build SDK: %v", err)
+	}
+	if _, ok := out["docs/index.md"]; ok {
+		t.Errorf("stale docs/index.md is not removed")
+	}
+	if strings.Contains(string(out["Types/Checkout.php"]), "@schema") {
+		t.Errorf("class is annotated without hooks")
+	}
+
+	err := testGenerator(t, Config{Output: MemoryOutput{}, Hooks: []Hook{&testHook{overwrite: "Types/Checkout.php"}}}).Build()
+	if err == nil || !strings.Contains(err.Error(), `file "Types/Checkout.php" is already generated`) {
+		t.Errorf("overwriting a generated file returned %v", err)
+	}
+
+	for _, name := range []string{"../x.php", "Types/../../x.php", "/tmp/x.php"} {
+		err = testGenerator(t, Config{Output: MemoryOutput{}, Hooks: []Hook{&testHook{overwrite: name}}}).Build()
+		if err == nil || !strings.Contains(err.Error(), fmt.Sprintf("file %q is outside the output directory", name)) {
+			t.Errorf("writing %q returned %v", name, err)
+		}
+	}
+
+	// The service file of a later tag is generated after the hook wrote it.
+	err = testGenerator(t, Config{Output: MemoryOutput{}, Hooks: []Hook{&testHook{early: "Transactions/Transactions.php"}}}).Build()
+	if err == nil || !strings.Contains(err.Error(), `file "Transactions/Transactions.php" written by a hook is also generated`) {
+		t.Errorf("generating a file written by a hook returned %v", err)
+	}
+
+	var events []string
+	hooks := []Hook{&orderHook{name: "a", events: &events}, &orderHook{name: "b", events: &events}}
+	if err := testGenerator(t, Config{Output: MemoryOutput{}, Hooks: hooks}).Build(); err != nil {
+		t.Fatalf("build SDK: %v", err)
+	}
+	want := []string{"a OnSchema", "b OnSchema", "a AfterClass", "b AfterClass"}
+	if got := events[:len(want)]; !slices.Equal(got, want) {
+		t.Errorf("hooks are called in order %v, want %v", got, want)
+	}
+}
+
+// testHook annotates the schema classes and the service methods, and writes a
+// file per service along with an index.
+type testHook struct {
+	NopHook
+
+	// overwrite is written once all the files are generated.
+	overwrite string
+	// early is written after the first class.
+	early    string
+	services int
+}
+
+func (h *testHook) OnSchema(_ *HookContext, schema *base.SchemaProxy, class *php.Class) error {
+	class.Doc = append(class.Doc, "@schema "+strings.Join(schema.Schema().Type, "|"))
+	return nil
+}
+
+func (h *testHook) OnOperation(_ *HookContext, op Operation, method *php.Method) error {
+	method.Doc = append(method.Doc, fmt.Sprintf("@operation %s %s %s", op.Spec.OperationId, op.Method, op.Path))
+	return nil
+}
+
+func (h *testHook) AfterClass(ctx *HookContext, namespace string, class *php.Class) error {
+	if h.early != "" {
+		early := h.early
+		h.early = ""
+		return ctx.WriteFile(early, nil)
+	}
+	if namespace != ctx.Namespace()+`\Services` || !slices.Contains(class.Implements, `\`+namespace+`\SumUpService`) {
+		return nil
+	}
+	h.services++
+	return ctx.WriteFile("docs/"+class.Name+".md", []byte("# "+namespace+`\`+class.Name+"\n"))
+}
+
+func (h *testHook) AfterBuild(ctx *HookContext) error {
+	if h.overwrite != "" {
+		return ctx.WriteFile(h.overwrite, nil)
+	}
+	return ctx.WriteFile("docs/index.md", fmt.Appendf(nil, "%d services\n", h.services))
+}
+
+// orderHook records the callbacks made on schema classes.
+type orderHook struct {
+	NopHook
+
+	name   string
+	events *[]string
+}
+
+func (h *orderHook) OnSchema(*HookContext, *base.SchemaProxy, *php.Class) error {
+	*h.events = append(*h.events, h.name+" OnSchema")
+	return nil
+}
+
+func (h *orderHook) AfterClass(*HookContext, string, *php.Class) error {
+	*h.events = append(*h.events, h.name+" AfterClass")
+	return nil
+}
+
+func testBuild(t *testing.T, cfg Config) string {
+	t.Helper()
+
+	return testBuildSpec(t, testSpec(t), cfg)
+}
+
+func testBuildSpec(t *testing.T, spec []byte, cfg Config) string {
+	t.Helper()
+
+	if cfg.Out == "" {
+		cfg.Out = t.TempDir()
+	}
+	g := testGeneratorSpec(t, spec, cfg)
+	if err := g.Build(); err != nil {
+		t.Fatalf("build SDK: %v", err)
+	}
+	return cfg.Out
+}
+
+// testSpec returns the OpenAPI document of the repository.
+func testSpec(t *testing.T) []byte {
+	t.Helper()
+
+	repositoryRoot, err := filepath.Abs(filepath.Join("..", "..", ".."))
+	if err != nil {
+		t.Fatalf("resolve repository root: %v", err)
+	}
+	spec, err := os.ReadFile(filepath.Join(repositoryRoot, "openapi.json"))
+	if err != nil {
+		t.Fatalf("read OpenAPI document: %v", err)
+	}
+	return spec
+}
+
+func testGenerator(t *testing.T, cfg Config) *Generator {
+	t.Helper()
+
+	return testGeneratorSpec(t, testSpec(t), cfg)
+}
+
+func testGeneratorSpec(t *testing.T, spec []byte, cfg Config) *Generator {
+	t.Helper()
+
+	model, err := ParseSpecs(spec)
+	if err != nil {
+		t.Fatalf("parse specs: %v", err)
+	}
+
+	g := New(cfg)
+	if err := g.Load(model); err != nil {
+		t.Fatalf("load generator: %v", err)
+	}
+	return g
+}
+
+func readGenerated(t *testing.T, out, name string) string {
+	t.Helper()
+
+	contents, err := os.ReadFile(filepath.Join(out, filepath.FromSlash(name)))
+	if err != nil {
+		t.Fatalf("read generated file %q: %v", name, err)
+	}
+	return string(contents)
+}
+
+// generatedClass returns the source of a single class declared in source,
+// from its declaration up to the next top-level declaration.
+func generatedClass(t *testing.T, source, className string) string {
+	t.Helper()
+
+	start := strings.Index(source, "\nclass "+className+" ")
+	if start < 0 {
+		start = strings.Index(source, "\nclass "+className+"\n")
+	}
+	if start < 0 {
+		t.Fatalf("class %q not found", className)
+	}
+	rest := source[start+1:]
+	if end := strings.Index(rest, "\n}\n"); end >= 0 {
+		rest = rest[:end+3]
+	}
+	return rest
+}
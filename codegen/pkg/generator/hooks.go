@@ -0,0 +1,175 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
+)
+
+// Hook extends the generation without forking the generator, such as to
+// annotate the classes or to write an extra file per service. Hooks are
+// registered through Config.Hooks and called in order: for each class, every
+// hook's OnSchema, then every hook's OnOperation, then every hook's
+// AfterClass.
+//
+// The declarations passed to the hooks are about to be printed: changes made
+// to them are written to the SDK. A hook returning an error stops the Build.
+// Embed NopHook to implement only some of the callbacks.
+type Hook interface {
+	// OnSchema is called for each class generated from a schema of the
+	// specs, before AfterClass.
+	OnSchema(ctx *HookContext, schema *base.SchemaProxy, class *php.Class) error
+
+	// OnOperation is called for each service method generated from an
+	// operation of the specs, before AfterClass is called for the service.
+	OnOperation(ctx *HookContext, op Operation, method *php.Method) error
+
+	// AfterClass is called for each generated class, including the services,
+	// with the namespace it is declared in.
+	AfterClass(ctx *HookContext, namespace string, class *php.Class) error
+
+	// AfterBuild is called once all the files are generated, before the
+	// stale files of the previous generation are removed.
+	AfterBuild(ctx *HookContext) error
+}
+
+// NopHook implements the callbacks of Hook as no-ops.
+type NopHook struct{}
+
+func (NopHook) OnSchema(*HookContext, *base.SchemaProxy, *php.Class) error { return nil }
+func (NopHook) OnOperation(*HookContext, Operation, *php.Method) error     { return nil }
+func (NopHook) AfterClass(*HookContext, string, *php.Class) error          { return nil }
+func (NopHook) AfterBuild(*HookContext) error                              { return nil }
+
+// Operation is an operation of the specs generated as a service method.
+type Operation struct {
+	// ID is the operation ID, or the one derived from the method and path
+	// when the specs have none.
+	ID string
+	// Method is the HTTP method in upper case.
+	Method string
+	Path   string
+	Spec   *v3.Operation
+}
+
+// HookContext is the handle the hooks use to inspect the specs and to emit
+// extra files. The files are recorded in the manifest and the report like the
+// generated ones, so that they are removed once no hook writes them anymore.
+type HookContext struct {
+	g *Generator
+}
+
+// Spec returns the loaded specs.
+func (c *HookContext) Spec() *v3.Document {
+	return c.g.spec
+}
+
+// Namespace returns the root namespace of the generated code.
+func (c *HookContext) Namespace() string {
+	return c.g.namespace
+}
+
+// WriteFile writes an extra file, name being relative to the output
+// directory and separated by forward slashes. A file cannot be outside the
+// output directory, be written twice, nor be one of the generated files: the
+// Build fails on such a name.
+func (c *HookContext) WriteFile(name string, data []byte) error {
+	if err := c.checkName(name); err != nil {
+		return err
+	}
+	if err := c.g.writeFile(name, string(data)); err != nil {
+		return err
+	}
+	c.g.hookFiles[name] = struct{}{}
+	return nil
+}
+
+// WritePHPFile imports the names referenced by the namespaces of a PHP file,
+// then prints and writes it. Its declarations are not passed to the hooks.
+func (c *HookContext) WritePHPFile(name string, file *php.File) error {
+	if err := c.checkName(name); err != nil {
+		return err
+	}
+	if err := c.g.printPHPFile(name, file); err != nil {
+		return err
+	}
+	c.g.hookFiles[name] = struct{}{}
+	return nil
+}
+
+func (c *HookContext) checkName(name string) error {
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return fmt.Errorf("file %q is outside the output directory", name)
+	}
+	if _, ok := c.g.generated.Files[name]; ok || name == manifestFilename {
+		return fmt.Errorf("file %q is already generated", name)
+	}
+	return nil
+}
+
+// runClassHooks passes the classes of a PHP file about to be written to the
+// hooks.
+func (g *Generator) runClassHooks(file *php.File) error {
+	if len(g.cfg.Hooks) == 0 {
+		return nil
+	}
+
+	ctx := &HookContext{g: g}
+	for _, namespace := range file.Namespaces {
+		for _, decl := range namespace.Decls {
+			class, ok := decl.(*php.Class)
+			if !ok {
+				continue
+			}
+			// Every hook sees the class before any of them finalizes it.
+			if schema, ok := g.classSchemas[class]; ok {
+				for _, hook := range g.cfg.Hooks {
+					if err := hook.OnSchema(ctx, schema, class); err != nil {
+						return fmt.Errorf("hook on schema of class %s: %w", class.Name, err)
+					}
+				}
+			}
+			for idx := range class.Methods {
+				op, ok := g.classOperations[class][class.Methods[idx].Name]
+				if !ok {
+					continue
+				}
+				for _, hook := range g.cfg.Hooks {
+					if err := hook.OnOperation(ctx, op.hookOperation(), &class.Methods[idx]); err != nil {
+						return fmt.Errorf("hook on operation %s: %w", op.OriginalID, err)
+					}
+				}
+			}
+			for _, hook := range g.cfg.Hooks {
+				if err := hook.AfterClass(ctx, namespace.Name, class); err != nil {
+					return fmt.Errorf("hook after class %s: %w", class.Name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// runBuildHooks calls the hooks once all the files are generated.
+func (g *Generator) runBuildHooks() error {
+	ctx := &HookContext{g: g}
+	for _, hook := range g.cfg.Hooks {
+		if err := hook.AfterBuild(ctx); err != nil {
+			return fmt.Errorf("hook after build: %w", err)
+		}
+	}
+	return nil
+}
+
+func (op *operation) hookOperation() Operation {
+	return Operation{
+		ID:     op.OriginalID,
+		Method: op.Method,
+		Path:   op.Path,
+		Spec:   op.spec,
+	}
+}
@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/orderedmap"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/sumup/sumup-php/codegen/pkg/extension"
+)
+
+// lifecycle is the deprecation and beta status of an operation, a schema or
+// a property, taken from `deprecated`, `x-deprecation-notice` and `x-beta`.
+type lifecycle struct {
+	Deprecated        bool
+	DeprecationNotice string
+	Beta              bool
+}
+
+func specLifecycle(deprecated *bool, extensions *orderedmap.Map[string, *yaml.Node]) lifecycle {
+	status := lifecycle{
+		DeprecationNotice: strings.TrimSpace(extension.GetOrDefault(extensions, "x-deprecation-notice", "")),
+		Beta:              extension.GetOrDefault(extensions, "x-beta", false),
+	}
+	// A deprecation notice is only given for deprecated elements.
+	status.Deprecated = (deprecated != nil && *deprecated) || status.DeprecationNotice != ""
+	return status
+}
+
+// doc returns the docblock tags of the status.
+func (l lifecycle) doc() []string {
+	var doc []string
+	if l.Deprecated {
+		doc = append(doc, strings.TrimSpace("@deprecated "+l.DeprecationNotice))
+	}
+	if l.Beta {
+		doc = append(doc, "@experimental")
+	}
+	return doc
+}
+
+// deprecationTrigger returns the statement raising E_USER_DEPRECATED when a
+// deprecated member of the current class, such as `create()` or
+// `$permissions`, is used. It is empty unless Config.TriggerDeprecations is
+// set. The error is silenced with @ like PHP's own deprecations, so that it
+// only reaches error handlers and logs.
+func (g *Generator) deprecationTrigger(member string, status lifecycle) string {
+	if !g.cfg.TriggerDeprecations || !status.Deprecated {
+		return ""
+	}
+
+	message := fmt.Sprintf("::%s is deprecated.", member)
+	if status.DeprecationNotice != "" {
+		message += " " + strings.Join(strings.Fields(status.DeprecationNotice), " ")
+	}
+	return fmt.Sprintf("@trigger_error(self::class . %s, E_USER_DEPRECATED);\n", phpString(message))
+}
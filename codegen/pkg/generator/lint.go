@@ -0,0 +1,328 @@
+package generator
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+
+	"github.com/sumup/sumup-php/codegen/pkg/extension"
+)
+
+// Severity is the severity of a lint problem.
+type Severity string
+
+const (
+	// SeverityError marks problems the generator works around by skipping
+	// parts of the specs, or which generate invalid PHP.
+	SeverityError Severity = "error"
+	// SeverityWarning marks problems the generator tolerates, such as names
+	// it renames.
+	SeverityWarning Severity = "warning"
+)
+
+// Problem is a violation of the conventions the generator relies on.
+type Problem struct {
+	Severity Severity `json:"severity"`
+	// Pointer is the JSON pointer of the offending part of the specs, such
+	// as `/paths/~1v0.1~1checkouts/post`.
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s: %s", p.Severity, p.Pointer, p.Message)
+}
+
+// jsonPointer joins the segments into a JSON pointer, escaping them as
+// described by RFC 6901.
+func jsonPointer(segments ...string) string {
+	var b strings.Builder
+	for _, segment := range segments {
+		segment = strings.ReplaceAll(segment, "~", "~0")
+		segment = strings.ReplaceAll(segment, "/", "~1")
+		b.WriteString("/" + segment)
+	}
+	return b.String()
+}
+
+// refPointer returns the JSON pointer of a local reference, such as
+// `#/components/schemas/Checkout`.
+func refPointer(ref string) string {
+	return strings.TrimPrefix(ref, "#")
+}
+
+// Lint checks the loaded specs against the conventions the generator relies
+// on, such as unique method names within a tag and class names that do not
+// collide. The problems are sorted by pointer.
+func (g *Generator) Lint() []Problem {
+	if g.spec == nil {
+		return nil
+	}
+
+	l := &linter{
+		g:       g,
+		names:   make(map[string]nameClaim),
+		visited: make(map[string]struct{}),
+	}
+	l.lintOperations()
+	l.lintTags()
+
+	slices.SortStableFunc(l.problems, func(a, b Problem) int {
+		return cmp.Compare(a.Pointer, b.Pointer)
+	})
+	return l.problems
+}
+
+// nameClaim records the part of the specs a PHP class or enum is generated
+// from.
+type nameClaim struct {
+	kind    string
+	pointer string
+}
+
+type linter struct {
+	g        *Generator
+	problems []Problem
+
+	// names maps the generated class and enum names to their source.
+	names map[string]nameClaim
+
+	// visited tracks the schemas already checked, by pointer.
+	visited map[string]struct{}
+}
+
+func (l *linter) report(severity Severity, pointer, format string, args ...any) {
+	l.problems = append(l.problems, Problem{
+		Severity: severity,
+		Pointer:  pointer,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) lintOperations() {
+	if l.g.spec.Paths == nil {
+		return
+	}
+
+	// methods maps tag keys to the pointers of the operations by method name.
+	methods := make(map[string]map[string]string)
+
+	for path, pathItem := range l.g.spec.Paths.PathItems.FromOldest() {
+		for method, op := range pathItem.GetOperations().FromOldest() {
+			if !l.g.includesOperation(op) {
+				continue
+			}
+			pointer := jsonPointer("paths", path, method)
+
+			if op.OperationId == "" {
+				l.report(SeverityError, pointer, "missing operationId")
+			}
+			if len(op.Tags) == 0 {
+				l.report(SeverityError, pointer, "missing tags, the operation is not generated")
+			}
+			if ext, ok := extension.Get[map[string]any](op.Extensions, "x-codegen"); ok {
+				if methodName, ok := ext["method_name"]; ok {
+					if name, ok := methodName.(string); !ok || name == "" {
+						l.report(SeverityWarning, pointer+"/x-codegen/method_name", "method name is not a non-empty string, ignoring it")
+					}
+				}
+			}
+
+			_, operationID := l.g.operationIDs(strings.ToUpper(method), path, op)
+			methodName := (&operation{ID: operationID}).methodName()
+			for idx, tag := range op.Tags {
+				tagKey := normalizeTagKey(tag)
+				if _, declared := l.g.tagLookup[tagKey]; !declared {
+					l.lintServiceName(pointer+jsonPointer("tags", strconv.Itoa(idx)), tagKey)
+				}
+				if methods[tagKey] == nil {
+					methods[tagKey] = make(map[string]string)
+				}
+				if previous, ok := methods[tagKey][methodName]; ok {
+					l.report(SeverityError, pointer, "method %s of tag %q is already generated for %s", methodName, tag, previous)
+					continue
+				}
+				methods[tagKey][methodName] = pointer
+			}
+
+			for idx, param := range op.Parameters {
+				if param != nil {
+					l.lintSchema(param.Schema, jsonPointer("paths", path, method, "parameters", strconv.Itoa(idx), "schema"), "")
+				}
+			}
+			if op.RequestBody != nil && op.RequestBody.Content != nil {
+				for mediaType, content := range op.RequestBody.Content.FromOldest() {
+					l.lintSchema(content.Schema, jsonPointer("paths", path, method, "requestBody", "content", mediaType, "schema"), "")
+				}
+			}
+			if op.Responses != nil {
+				for code, response := range op.Responses.Codes.FromOldest() {
+					if response.Content == nil {
+						continue
+					}
+					for mediaType, content := range response.Content.FromOldest() {
+						l.lintSchema(content.Schema, jsonPointer("paths", path, method, "responses", code, "content", mediaType, "schema"), "")
+					}
+				}
+			}
+		}
+	}
+}
+
+// lintTags checks the service classes and namespaces named after the tags.
+func (l *linter) lintTags() {
+	for idx, tag := range l.g.spec.Tags {
+		if tag == nil {
+			continue
+		}
+		l.lintServiceName(jsonPointer("tags", strconv.Itoa(idx)), normalizeTagKey(tag.Name))
+	}
+}
+
+func (l *linter) lintServiceName(pointer, tagKey string) {
+	if name := l.g.serviceBaseName(tagKey); isPHPReservedName(name) {
+		l.report(SeverityWarning, pointer, "service %s is named after a PHP reserved word, it is generated as %s", name, l.g.displayTagName(tagKey))
+	}
+}
+
+// lintSchema checks the names of the classes and enums generated for the
+// schema and its nested schemas, mirroring collectSchemaUsageFromSchema.
+func (l *linter) lintSchema(schema *base.SchemaProxy, pointer string, suggestedName string) {
+	if schema == nil {
+		return
+	}
+	if wrapped := schemaWrapped(schema); wrapped != nil {
+		l.lintWrapped(schema, wrapped, pointer, suggestedName)
+		return
+	}
+	if ref := schema.GetReference(); ref != "" {
+		pointer = refPointer(ref)
+	}
+
+	if _, ok := l.visited[pointer]; ok {
+		return
+	}
+	l.visited[pointer] = struct{}{}
+
+	spec := schema.Schema()
+	if spec == nil {
+		return
+	}
+
+	// Collisions are checked on the names before the generator resolves
+	// them.
+	name := ""
+	if ref := schema.GetReference(); ref != "" {
+		name = l.g.componentClassName(strings.TrimPrefix(ref, "#/components/schemas/"), schema)
+	} else if schemaShouldGenerateClass(schema) && suggestedName != "" {
+		name = suggestedName
+		if override := schemaClassNameOverride(schema); override != "" {
+			name = override
+		}
+	}
+	if name != "" && (schemaIsMapClass(schema) || schemaShouldGenerateClass(schema) && schemaIsObject(schema)) {
+		l.claim(name, "class", pointer)
+	}
+	parentName := name
+	if parentName == "" {
+		parentName = suggestedName
+	}
+	l.lintSchemaMembers(schema, spec, pointer, name, parentName)
+}
+
+// lintWrapped checks the schema a wrapper stands for, at the pointer of its
+// composition member.
+func (l *linter) lintWrapped(schema, wrapped *base.SchemaProxy, pointer string, suggestedName string) {
+	spec := schema.Schema()
+	for _, composite := range []struct {
+		keyword string
+		schemas []*base.SchemaProxy
+	}{
+		{"allOf", spec.AllOf},
+		{"anyOf", spec.AnyOf},
+		{"oneOf", spec.OneOf},
+	} {
+		if idx := slices.Index(composite.schemas, wrapped); idx >= 0 {
+			l.lintSchema(wrapped, pointer+jsonPointer(composite.keyword, strconv.Itoa(idx)), suggestedName)
+			return
+		}
+	}
+}
+
+// lintSchemaMembers checks the nested schemas of a schema generated as the
+// class name, if any. Inline allOf, anyOf and oneOf members are part of the
+// same class.
+func (l *linter) lintSchemaMembers(schema *base.SchemaProxy, spec *base.Schema, pointer string, name string, parentName string) {
+	if spec.Properties != nil {
+		for propName, propSchema := range spec.Properties.FromOldest() {
+			propPointer := pointer + jsonPointer("properties", propName)
+			if propSchema == nil {
+				continue
+			}
+			if propSpec := propSchema.Schema(); propSpec != nil && len(propSpec.Enum) > 0 && name != "" {
+				l.claim(phpEnumName(name, propName), "enum", propPointer)
+			}
+			l.lintSchema(propSchema, propPointer, l.g.inlinePropertyClassName(parentName, propName, propSchema))
+		}
+	}
+
+	if hasSchemaType(spec, "array") && spec.Items != nil && spec.Items.A != nil {
+		l.lintSchema(spec.Items.A, pointer+"/items", l.g.inlineArrayItemClassName(parentName, spec.Items.A))
+	}
+
+	if values := schemaMapValues(schema); values != nil {
+		l.lintSchema(values, pointer+"/additionalProperties", l.g.inlineMapValueClassName(parentName, values))
+	}
+
+	composites := []struct {
+		keyword string
+		schemas []*base.SchemaProxy
+	}{
+		{"allOf", spec.AllOf},
+		{"anyOf", spec.AnyOf},
+		{"oneOf", spec.OneOf},
+	}
+	for _, composite := range composites {
+		for idx, member := range composite.schemas {
+			memberPointer := pointer + jsonPointer(composite.keyword, strconv.Itoa(idx))
+			if member == nil || member.GetReference() != "" {
+				l.lintSchema(member, memberPointer, parentName)
+				continue
+			}
+			if _, ok := l.visited[memberPointer]; ok {
+				continue
+			}
+			l.visited[memberPointer] = struct{}{}
+			if memberSpec := member.Schema(); memberSpec != nil {
+				memberName := name
+				if memberName == "" && schemaShouldGenerateClass(member) {
+					memberName = parentName
+				}
+				l.lintSchemaMembers(member, memberSpec, memberPointer, memberName, parentName)
+			}
+		}
+	}
+}
+
+// claim records the source of a generated class or enum, reporting names
+// that are reserved or already generated from another part of the specs,
+// which the generator renames.
+func (l *linter) claim(name, kind, pointer string) {
+	if isPHPReservedName(name) {
+		l.report(SeverityWarning, pointer, "%s %s is named after a PHP reserved word, it is generated as %s", kind, name, name+l.g.modelNames.reservedSuffix)
+	}
+
+	key := strings.ToLower(name)
+	previous, ok := l.names[key]
+	if !ok {
+		l.names[key] = nameClaim{kind: kind, pointer: pointer}
+		return
+	}
+	if previous.pointer != pointer {
+		l.report(SeverityWarning, pointer, "%s %s collides with the %s generated for %s, one of them is renamed", kind, name, previous.kind, previous.pointer)
+	}
+}
@@ -0,0 +1,211 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
+)
+
+// manifestFilename is the file of the output directory listing the files of
+// the last generation, so that the next one can remove those it no longer
+// produces.
+const manifestFilename = ".codegen-manifest.json"
+
+// manifest is the layout of the generation manifest.
+type manifest struct {
+	// Files maps the generated files, relative to the output directory and
+	// separated by forward slashes, to the SHA-256 of their content.
+	Files map[string]string `json:"files"`
+}
+
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// writeFile writes a generated file, name being relative to the output
+// directory, and records it in the manifest of the generation.
+func (g *Generator) writeFile(name string, content string) error {
+	// The files written by the hooks are recorded once written, so that the
+	// generator fails rather than overwrite them.
+	if _, ok := g.hookFiles[name]; ok {
+		return fmt.Errorf("file %q written by a hook is also generated", name)
+	}
+	if err := g.out.WriteFile(name, []byte(content)); err != nil {
+		return err
+	}
+	g.generated.Files[name] = contentHash([]byte(content))
+	return nil
+}
+
+// writePHPFile passes the classes of a PHP file to the hooks, then prints and
+// writes it.
+func (g *Generator) writePHPFile(name string, file *php.File) error {
+	if err := g.runClassHooks(file); err != nil {
+		return err
+	}
+	return g.printPHPFile(name, file)
+}
+
+// printPHPFile imports the names referenced by the namespaces of a PHP file,
+// then prints and writes it without running the hooks.
+func (g *Generator) printPHPFile(name string, file *php.File) error {
+	if g.surface != nil {
+		g.surface.addFile(file)
+	}
+	g.addEmitted(file)
+	for _, namespace := range file.Namespaces {
+		namespace.ImportNames()
+	}
+	return g.writeFile(name, php.Print(file))
+}
+
+// readManifest reads the manifest of the previous generation, reporting
+// whether there is one. An output directory without one yields an empty
+// manifest.
+func (g *Generator) readManifest() (manifest, bool, error) {
+	contents, err := g.out.ReadFile(manifestFilename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return manifest{Files: map[string]string{}}, false, nil
+	}
+	if err != nil {
+		return manifest{}, false, fmt.Errorf("read manifest: %w", err)
+	}
+
+	var previous manifest
+	if err := json.Unmarshal(contents, &previous); err != nil {
+		return manifest{}, false, fmt.Errorf("decode manifest %q: %w", manifestFilename, err)
+	}
+	return previous, true, nil
+}
+
+func (g *Generator) writeManifest() error {
+	contents, err := json.MarshalIndent(g.generated, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode manifest: %w", err)
+	}
+
+	return g.out.WriteFile(manifestFilename, append(contents, '\n'))
+}
+
+// RehashManifest records in the manifest the files it lists as they are in
+// the output, such as once a formatter rewrote them. The manifest of Build
+// holds the hashes of the files as generated, against which the next run
+// tells the files edited since from the stale ones, so a formatted file would
+// otherwise be kept as edited. Listed files missing from the output keep
+// their hash.
+func (g *Generator) RehashManifest() error {
+	current, ok, err := g.readManifest()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("missing manifest %q: generate the SDK first", manifestFilename)
+	}
+
+	for name := range current.Files {
+		if !filepath.IsLocal(filepath.FromSlash(name)) {
+			continue
+		}
+		contents, err := g.out.ReadFile(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("read generated file: %w", err)
+		}
+		current.Files[name] = contentHash(contents)
+	}
+
+	g.generated = current
+	return g.writeManifest()
+}
+
+// pruneStaleFiles removes the files of the previous generation that the
+// current one did not produce. Only files listed in the previous manifest are
+// candidates, so hand-written files are never touched, and a listed file
+// edited since its generation, or since RehashManifest, is kept.
+func (g *Generator) pruneStaleFiles(previous manifest) error {
+	for name, hash := range previous.Files {
+		if _, ok := g.generated.Files[name]; ok {
+			continue
+		}
+		if !filepath.IsLocal(filepath.FromSlash(name)) {
+			slog.Warn("ignoring manifest entry outside of the output directory", slog.String("file", name))
+			continue
+		}
+
+		contents, err := g.out.ReadFile(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("read stale file: %w", err)
+		}
+		if contentHash(contents) != hash {
+			slog.Warn("keeping stale file modified since its generation", slog.String("file", name))
+			continue
+		}
+
+		if err := g.out.Remove(name); err != nil {
+			return fmt.Errorf("remove stale file: %w", err)
+		}
+		slog.Info("removing stale file", slog.String("file", name))
+	}
+	return nil
+}
+
+// removeUnlistedFiles cleans an output without manifest, such as a checkout
+// generated before manifests existed, when Config.CleanWithoutManifest is
+// set. The files of the directories owned by
+// the generator that the generation did not produce are removed, along with
+// the services older generations wrote in the services directory. The
+// hand-written HttpClient classes and root files are kept. Outputs unable to
+// list their files are left as is.
+func (g *Generator) removeUnlistedFiles() error {
+	lister, ok := g.out.(fileLister)
+	if !ok {
+		return nil
+	}
+
+	dirs := append([]string{g.layout.Types, g.layout.Shared}, g.tagDirs...)
+	slices.Sort(dirs)
+	var stale []string
+	for _, dir := range slices.Compact(dirs) {
+		if dir == g.layout.Services || dir == "HttpClient" {
+			continue
+		}
+		names, err := lister.listFiles(dir)
+		if err != nil {
+			return fmt.Errorf("list generated files: %w", err)
+		}
+		stale = append(stale, names...)
+	}
+	for _, service := range g.emitted.Services {
+		name := path.Join(g.layout.Services, service[strings.LastIndex(service, `\`)+1:]+".php")
+		if _, err := g.out.ReadFile(name); err == nil {
+			stale = append(stale, name)
+		}
+	}
+
+	for _, name := range stale {
+		if _, ok := g.generated.Files[name]; ok {
+			continue
+		}
+		if err := g.out.Remove(name); err != nil {
+			return fmt.Errorf("remove unlisted file: %w", err)
+		}
+		slog.Info("removing file missing from the generation", slog.String("file", name))
+	}
+	return nil
+}
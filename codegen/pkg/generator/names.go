@@ -1,10 +1,13 @@
 package generator
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/iancoleman/strcase"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+
+	"github.com/sumup/sumup-php/codegen/pkg/extension"
 )
 
 func schemaClassName(schema *base.SchemaProxy) string {
@@ -13,10 +16,7 @@ func schemaClassName(schema *base.SchemaProxy) string {
 	}
 
 	if ref := schema.GetReference(); ref != "" {
-		name := strings.TrimPrefix(ref, "#/components/schemas/")
-		name = strings.ReplaceAll(name, ".", "_")
-		name = strings.ReplaceAll(name, "-", "_")
-		return strcase.ToCamel(name)
+		return componentSchemaClassName(strings.TrimPrefix(ref, "#/components/schemas/"))
 	}
 
 	if schema.Schema() != nil && schema.Schema().Title != "" {
@@ -26,6 +26,26 @@ func schemaClassName(schema *base.SchemaProxy) string {
 	return "Model"
 }
 
+func componentSchemaClassName(name string) string {
+	name = strings.ReplaceAll(name, ".", "_")
+	name = strings.ReplaceAll(name, "-", "_")
+	return strcase.ToCamel(name)
+}
+
+// schemaClassNameOverride returns the class name set by the
+// x-codegen.class_name extension of the schema, if any.
+func schemaClassNameOverride(schema *base.SchemaProxy) string {
+	if schema == nil || schema.Schema() == nil {
+		return ""
+	}
+	ext, ok := extension.Get[map[string]any](schema.Schema().Extensions, "x-codegen")
+	if !ok {
+		return ""
+	}
+	name, _ := ext["class_name"].(string)
+	return name
+}
+
 func normalizeTagKey(tag string) string {
 	tag = strings.TrimSpace(strings.ToLower(tag))
 	if tag == "" {
@@ -62,6 +82,25 @@ func phpInlineObjectName(schemaName, propertyName string) string {
 	return schemaName + baseName
 }
 
+func phpConstantName(propertyName string) string {
+	propertyName = strings.TrimSpace(propertyName)
+	propertyName = strings.ReplaceAll(propertyName, "-", "_")
+	propertyName = strings.ReplaceAll(propertyName, ".", "_")
+	return strcase.ToScreamingSnake(propertyName)
+}
+
+func phpInterfaceName(className string) string {
+	return className + "Interface"
+}
+
+func phpSetterName(propertyName string) string {
+	return "set" + strcase.ToCamel(propertyName)
+}
+
+func phpWitherName(propertyName string) string {
+	return "with" + strcase.ToCamel(propertyName)
+}
+
 func phpEnumCaseName(value string) string {
 	value = strings.TrimSpace(value)
 
@@ -107,3 +146,110 @@ func phpEnumCaseName(value string) string {
 
 	return value
 }
+
+// nameRegistry hands out unique PHP class names. The first owner claiming a
+// name gets it, later owners get it suffixed with a number. Names are
+// compared case-insensitively like PHP does, and PHP reserved words get the
+// registry suffix appended. Claims are idempotent: an owner keeps the name
+// it got first, so names only depend on the order of the first claims.
+type nameRegistry struct {
+	// reservedSuffix is appended to names that are PHP reserved words.
+	reservedSuffix string
+
+	// owners maps the lowercased names to their owner.
+	owners map[string]any
+
+	// names maps the owners to their name.
+	names map[any]string
+}
+
+func newNameRegistry(reservedSuffix string) *nameRegistry {
+	return &nameRegistry{
+		reservedSuffix: reservedSuffix,
+		owners:         make(map[string]any),
+		names:          make(map[any]string),
+	}
+}
+
+// claim returns the unique name of the owner, derived from name when the
+// owner has none yet.
+func (r *nameRegistry) claim(owner any, name string) string {
+	if claimed, ok := r.names[owner]; ok {
+		return claimed
+	}
+
+	if isPHPReservedName(name) {
+		name += r.reservedSuffix
+	}
+	candidate := name
+	for idx := 2; ; idx++ {
+		if _, taken := r.owners[strings.ToLower(candidate)]; !taken {
+			break
+		}
+		candidate = name + strconv.Itoa(idx)
+	}
+
+	r.owners[strings.ToLower(candidate)] = owner
+	r.names[owner] = candidate
+	return candidate
+}
+
+// lookup returns the name claimed by the owner, if any.
+func (r *nameRegistry) lookup(owner any) (string, bool) {
+	name, ok := r.names[owner]
+	return name, ok
+}
+
+// enumOwner identifies the enum generated for a property of a class.
+type enumOwner struct {
+	class    string
+	property string
+}
+
+// operationClassOwner identifies a class generated in the services namespace
+// for an operation of a service, such as its request body or query
+// parameters.
+type operationClassOwner struct {
+	service string
+	method  string
+	path    string
+	role    string
+}
+
+// tagOwner identifies the service generated for a tag.
+type tagOwner string
+
+// interfaceOwner identifies the marker interface generated for a class used
+// as one of several allOf parents.
+type interfaceOwner string
+
+// inlineClassOwner identifies a class generated for an inline schema, by the
+// name of the enclosing class and the position of the schema in it: a
+// property name, "[]" for array items or "{}" for map values.
+type inlineClassOwner struct {
+	parent   string
+	position string
+}
+
+// phpReservedNames are the words PHP reserves, which cannot name classes,
+// interfaces or enums. They are matched case-insensitively.
+var phpReservedNames = map[string]struct{}{
+	"abstract": {}, "and": {}, "array": {}, "as": {}, "bool": {}, "break": {}, "callable": {},
+	"case": {}, "catch": {}, "class": {}, "clone": {}, "const": {}, "continue": {}, "declare": {},
+	"default": {}, "die": {}, "do": {}, "echo": {}, "else": {}, "elseif": {}, "empty": {},
+	"enddeclare": {}, "endfor": {}, "endforeach": {}, "endif": {}, "endswitch": {}, "endwhile": {},
+	"enum": {}, "eval": {}, "exit": {}, "extends": {}, "false": {}, "final": {}, "finally": {},
+	"float": {}, "fn": {}, "for": {}, "foreach": {}, "function": {}, "global": {}, "goto": {},
+	"if": {}, "implements": {}, "include": {}, "include_once": {}, "instanceof": {}, "insteadof": {},
+	"int": {}, "interface": {}, "isset": {}, "iterable": {}, "list": {}, "match": {}, "mixed": {},
+	"namespace": {}, "never": {}, "new": {}, "null": {}, "numeric": {}, "object": {}, "or": {},
+	"parent": {}, "print": {}, "private": {}, "protected": {}, "public": {}, "readonly": {},
+	"require": {}, "require_once": {}, "resource": {}, "return": {}, "self": {}, "static": {},
+	"string": {}, "switch": {}, "throw": {}, "trait": {}, "true": {}, "try": {}, "unset": {},
+	"use": {}, "var": {}, "void": {}, "while": {}, "xor": {}, "yield": {},
+}
+
+func isPHPReservedName(name string) bool {
+	_, ok := phpReservedNames[strings.ToLower(name)]
+	return ok
+}
@@ -29,8 +29,11 @@ type operation struct {
 	BodyDocType  string
 	BodySchema   *base.SchemaProxy
 	BodyRequired bool
-	Deprecated   bool
 	Responses    []*operationResponse
+	lifecycle
+
+	// spec is the operation of the specs, passed to the hooks.
+	spec *v3.Operation
 }
 
 type operationParam struct {
@@ -40,6 +43,7 @@ type operationParam struct {
 	Type         string
 	DocType      string
 	Required     bool
+	Default      string
 }
 
 type operationResponse struct {
@@ -78,7 +82,7 @@ func (g *Generator) collectOperations() map[string][]*operation {
 
 	for path, pathItem := range g.spec.Paths.PathItems.FromOldest() {
 		for method, op := range pathItem.GetOperations().FromOldest() {
-			if op == nil {
+			if !g.includesOperation(op) {
 				continue
 			}
 
@@ -102,6 +106,7 @@ func (g *Generator) collectOperations() map[string][]*operation {
 					slog.String("path", path),
 					slog.String("method", method),
 				)
+				g.downgrade(DowngradeUntaggedOperation, jsonPointer("paths", path, method), op, "operation %s has no tags, no service generates it", built.OriginalID)
 				continue
 			}
 
@@ -122,23 +127,8 @@ func (g *Generator) collectOperations() map[string][]*operation {
 }
 
 func (g *Generator) buildOperation(method, path string, op *v3.Operation, params []*v3.Parameter) (*operation, error) {
-	originalOperationID := op.OperationId
-	if originalOperationID == "" {
-		trimmed := strings.ReplaceAll(path, "/", "_")
-		if trimmed == "" {
-			trimmed = "root"
-		}
-		originalOperationID = fmt.Sprintf("%s_%s", strings.ToLower(method), trimmed)
-	}
-
-	operationID := originalOperationID
-	if ext, ok := extension.Get[map[string]any](op.Extensions, "x-codegen"); ok {
-		if methodName, ok := ext["method_name"]; ok {
-			if methodStr, ok := methodName.(string); ok && methodStr != "" {
-				operationID = methodStr
-			}
-		}
-	}
+	originalOperationID, operationID := g.operationIDs(method, path, op)
+	pointer := jsonPointer("paths", path, strings.ToLower(method))
 
 	pathParams := make([]operationParam, 0)
 	queryParams := make([]operationParam, 0)
@@ -159,7 +149,7 @@ func (g *Generator) buildOperation(method, path string, op *v3.Operation, params
 			if param.Required != nil {
 				required = *param.Required
 			}
-			paramType, paramDocType := g.resolvePHPType(param.Schema, "SumUp\\Services", "", "")
+			paramType, paramDocType := g.resolvePHPType(param.Schema, g.servicesNamespace(), "", "")
 			queryParams = append(queryParams, operationParam{
 				OriginalName: param.Name,
 				VarName:      phpPropertyName(param.Name),
@@ -167,16 +157,17 @@ func (g *Generator) buildOperation(method, path string, op *v3.Operation, params
 				Type:         paramType,
 				DocType:      paramDocType,
 				Required:     required,
+				Default:      g.phpDefaultValue(param.Schema, paramType),
 			})
+		case "header", "cookie":
+			g.downgrade(DowngradeParameter, pointer, param, "%s parameter %q is not accepted by the service method", param.In, param.Name)
 		}
 	}
 
 	hasBody := op.RequestBody != nil
-	bodyType, bodyDocType, bodyRequired, bodySchema := g.resolveOperationBody(op)
-	deprecated := false
-	if op.Deprecated != nil {
-		deprecated = *op.Deprecated
-	}
+	bodyType, bodyDocType, bodyRequired, bodySchema := g.resolveOperationBody(op, pointer)
+	status := specLifecycle(op.Deprecated, op.Extensions)
+	status.Beta = g.isBetaOperation(op)
 
 	return &operation{
 		ID:           operationID,
@@ -193,12 +184,13 @@ func (g *Generator) buildOperation(method, path string, op *v3.Operation, params
 		BodyDocType:  bodyDocType,
 		BodySchema:   bodySchema,
 		BodyRequired: bodyRequired,
-		Deprecated:   deprecated,
-		Responses:    g.collectOperationResponses(op, originalOperationID),
+		Responses:    g.collectOperationResponses(op, originalOperationID, pointer),
+		lifecycle:    status,
+		spec:         op,
 	}, nil
 }
 
-func (g *Generator) resolveOperationBody(op *v3.Operation) (string, string, bool, *base.SchemaProxy) {
+func (g *Generator) resolveOperationBody(op *v3.Operation, pointer string) (string, string, bool, *base.SchemaProxy) {
 	if op == nil || op.RequestBody == nil {
 		return "", "", false, nil
 	}
@@ -211,9 +203,12 @@ func (g *Generator) resolveOperationBody(op *v3.Operation) (string, string, bool
 			schema = mediaType.Schema
 		}
 		if schema == nil {
-			for _, mediaType := range op.RequestBody.Content.FromOldest() {
+			for name, mediaType := range op.RequestBody.Content.FromOldest() {
 				if mediaType != nil && mediaType.Schema != nil {
 					schema = mediaType.Schema
+					if !isJSONMediaType(name) {
+						g.downgrade(DowngradeNonJSONBody, pointer, op.RequestBody, "request body of media type %s is encoded as JSON", name)
+					}
 					break
 				}
 			}
@@ -224,7 +219,7 @@ func (g *Generator) resolveOperationBody(op *v3.Operation) (string, string, bool
 		return "array", "array<string, mixed>", required, nil
 	}
 
-	bodyType, bodyDocType := g.resolvePHPType(schema, "SumUp\\Services", "", "")
+	bodyType, bodyDocType := g.resolvePHPType(schema, g.servicesNamespace(), "", "")
 	if bodyType == "" {
 		bodyType = "array"
 	}
@@ -235,6 +230,34 @@ func (g *Generator) resolveOperationBody(op *v3.Operation) (string, string, bool
 	return bodyType, bodyDocType, required, schema
 }
 
+// operationIDs returns the operation ID of an operation, derived from its
+// method and path when missing, and the ID its method is named after, which
+// x-codegen.method_name and Config.OperationNames override.
+func (g *Generator) operationIDs(method, path string, op *v3.Operation) (string, string) {
+	originalOperationID := op.OperationId
+	if originalOperationID == "" {
+		trimmed := strings.ReplaceAll(path, "/", "_")
+		if trimmed == "" {
+			trimmed = "root"
+		}
+		originalOperationID = fmt.Sprintf("%s_%s", strings.ToLower(method), trimmed)
+	}
+
+	operationID := originalOperationID
+	if ext, ok := extension.Get[map[string]any](op.Extensions, "x-codegen"); ok {
+		if methodName, ok := ext["method_name"]; ok {
+			if methodStr, ok := methodName.(string); ok && methodStr != "" {
+				operationID = methodStr
+			}
+		}
+	}
+	if methodName, ok := g.cfg.OperationNames[originalOperationID]; ok {
+		operationID = methodName
+	}
+
+	return originalOperationID, operationID
+}
+
 func (op *operation) methodName() string {
 	if op == nil {
 		return ""
@@ -247,7 +270,7 @@ func (op *operation) methodName() string {
 	return strcase.ToLowerCamel(op.ID)
 }
 
-func (g *Generator) collectOperationResponses(op *v3.Operation, operationID string) []*operationResponse {
+func (g *Generator) collectOperationResponses(op *v3.Operation, operationID string, pointer string) []*operationResponse {
 	if op == nil || op.Responses == nil || op.Responses.Codes.Len() == 0 {
 		return nil
 	}
@@ -255,7 +278,7 @@ func (g *Generator) collectOperationResponses(op *v3.Operation, operationID stri
 	responses := make([]*operationResponse, 0, op.Responses.Codes.Len())
 
 	for status, response := range op.Responses.Codes.FromOldest() {
-		respType := g.responseTypeForResponse(response, "SumUp\\Services", operationID, status)
+		respType := g.responseTypeForResponse(response, g.servicesNamespace(), operationID, status, pointer)
 		if respType == nil {
 			continue
 		}
@@ -275,7 +298,7 @@ func (g *Generator) collectOperationResponses(op *v3.Operation, operationID stri
 	return responses
 }
 
-func (g *Generator) responseTypeForResponse(resp *v3.Response, currentNamespace string, operationID string, statusCode string) *responseType {
+func (g *Generator) responseTypeForResponse(resp *v3.Response, currentNamespace string, operationID string, statusCode string, pointer string) *responseType {
 	if resp == nil {
 		return &responseType{Kind: responseTypeVoid}
 	}
@@ -290,6 +313,11 @@ func (g *Generator) responseTypeForResponse(resp *v3.Response, currentNamespace
 		schema = mediaType.Schema
 	} else if mediaType, ok := resp.Content.Get("application/json"); ok {
 		schema = mediaType.Schema
+	} else {
+		for name := range resp.Content.KeysFromOldest() {
+			g.downgrade(DowngradeNonJSONBody, pointer, resp, "%s response body of media type %s is ignored", statusCode, name)
+			break
+		}
 	}
 
 	if schema == nil {
@@ -304,6 +332,10 @@ func (g *Generator) buildResponseType(schema *base.SchemaProxy, currentNamespace
 		return nil
 	}
 
+	if wrapped := schemaWrapped(schema); wrapped != nil {
+		return g.buildResponseType(wrapped, currentNamespace, inlineBaseName)
+	}
+
 	if ref := schema.GetReference(); ref != "" {
 		if !schemaIsObject(schema) {
 			return g.buildResponseTypeFromSpec(schema.Schema(), currentNamespace)
@@ -313,7 +345,7 @@ func (g *Generator) buildResponseType(schema *base.SchemaProxy, currentNamespace
 			return &responseType{Kind: responseTypeObject}
 		}
 
-		name := schemaClassName(schema)
+		name := g.classNameForSchema(schema)
 		namespace := g.schemaNamespaces[name]
 		typeName := name
 		if namespace != "" && namespace != currentNamespace {
@@ -349,7 +381,7 @@ func (g *Generator) buildResponseType(schema *base.SchemaProxy, currentNamespace
 	if hasSchemaType(spec, "object") && inlineBaseName != "" && schemaShouldGenerateClass(schema) {
 		return &responseType{
 			Kind:            responseTypeClass,
-			ClassName:       fmt.Sprintf("\\SumUp\\Services\\%s", inlineBaseName),
+			ClassName:       g.serviceName(inlineBaseName),
 			InlineClassName: inlineBaseName,
 			InlineSchema:    schema,
 		}
@@ -370,12 +402,25 @@ func (g *Generator) buildResponseTypeFromSpec(spec *base.Schema, currentNamespac
 		}
 	}
 
+	if mapped := g.mappedType(spec); mapped != "" {
+		return &responseType{Kind: responseTypeScalar, ScalarType: mapped}
+	}
+
+	if len(spec.Type) == 0 {
+		if constType := schemaConstType(spec); constType != "" {
+			return &responseType{Kind: responseTypeScalar, ScalarType: constType}
+		}
+	}
+
 	switch {
 	case hasSchemaType(spec, "string"):
+		g.checkFormat(spec, "string")
 		return &responseType{Kind: responseTypeScalar, ScalarType: "string"}
 	case hasSchemaType(spec, "integer"):
+		g.checkFormat(spec, "int")
 		return &responseType{Kind: responseTypeScalar, ScalarType: "int"}
 	case hasSchemaType(spec, "number"):
+		g.checkFormat(spec, "float")
 		return &responseType{Kind: responseTypeScalar, ScalarType: "float"}
 	case hasSchemaType(spec, "boolean"):
 		return &responseType{Kind: responseTypeScalar, ScalarType: "bool"}
@@ -394,12 +439,21 @@ func (g *Generator) buildResponseTypeFromSpec(spec *base.Schema, currentNamespac
 	}
 
 	if len(spec.OneOf) > 0 || len(spec.AllOf) > 0 || len(spec.AnyOf) > 0 {
+		g.downgradeComposition(spec)
 		return &responseType{Kind: responseTypeMixed}
 	}
 
 	return &responseType{Kind: responseTypeMixed}
 }
 
+// isJSONMediaType reports whether a media type is JSON, such as
+// application/json or application/merge-patch+json.
+func isJSONMediaType(mediaType string) bool {
+	mediaType, _, _ = strings.Cut(mediaType, ";")
+	mediaType = strings.TrimSpace(mediaType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
 func inlineResponseClassName(operationID string, statusCode string) string {
 	if operationID == "" {
 		return ""
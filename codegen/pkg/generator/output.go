@@ -0,0 +1,233 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Output stores the generated files. Names are relative to the output
+// directory and separated by forward slashes.
+type Output interface {
+	// ReadFile returns the content of a file, or an error matching
+	// fs.ErrNotExist when it does not exist.
+	ReadFile(name string) ([]byte, error)
+
+	// WriteFile creates or replaces a file.
+	WriteFile(name string, data []byte) error
+
+	// Remove removes a file.
+	Remove(name string) error
+}
+
+// fileLister is implemented by the outputs able to list their files, which
+// lets a generation without manifest clean the directories of the generator.
+type fileLister interface {
+	// listFiles returns the names of the files below a directory, which
+	// may not exist.
+	listFiles(dir string) ([]string, error)
+}
+
+// DirOutput stores the files in a directory of the file system. It is the
+// output of a Config without one.
+type DirOutput struct {
+	Dir string
+}
+
+func (o DirOutput) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(o.Dir, filepath.FromSlash(name)))
+}
+
+func (o DirOutput) WriteFile(name string, data []byte) error {
+	filename := filepath.Join(o.Dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filename), os.ModePerm); err != nil {
+		return fmt.Errorf("create directory of %q: %w", filename, err)
+	}
+	if err := os.WriteFile(filename, data, 0o644); err != nil {
+		return fmt.Errorf("write file %q: %w", filename, err)
+	}
+	return nil
+}
+
+// Remove removes the file along with the directories it leaves empty.
+func (o DirOutput) Remove(name string) error {
+	filename := filepath.Join(o.Dir, filepath.FromSlash(name))
+	if err := os.Remove(filename); err != nil {
+		return fmt.Errorf("remove file %q: %w", filename, err)
+	}
+
+	// Removing a directory fails once it is not empty, which ends the walk up
+	// to the output directory.
+	for dir := filepath.Dir(filename); dir != filepath.Clean(o.Dir); dir = filepath.Dir(dir) {
+		if err := os.Remove(dir); err != nil {
+			break
+		}
+	}
+	return nil
+}
+
+func (o DirOutput) listFiles(dir string) ([]string, error) {
+	var names []string
+	err := filepath.WalkDir(filepath.Join(o.Dir, filepath.FromSlash(dir)), func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		name, err := filepath.Rel(o.Dir, filename)
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(name))
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return names, err
+}
+
+// MemoryOutput stores the files in memory, keyed by name, so that the SDK can
+// be rendered without a file system and then packaged at will.
+type MemoryOutput map[string][]byte
+
+func (o MemoryOutput) ReadFile(name string) ([]byte, error) {
+	data, ok := o[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+	return bytes.Clone(data), nil
+}
+
+func (o MemoryOutput) WriteFile(name string, data []byte) error {
+	o[name] = bytes.Clone(data)
+	return nil
+}
+
+func (o MemoryOutput) Remove(name string) error {
+	if _, ok := o[name]; !ok {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrNotExist}
+	}
+	delete(o, name)
+	return nil
+}
+
+func (o MemoryOutput) listFiles(dir string) ([]string, error) {
+	var names []string
+	for name := range o {
+		if strings.HasPrefix(name, dir+"/") {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// overlayOutput records the writes and removals made over another output
+// without applying them, reading through to it for the untouched files.
+type overlayOutput struct {
+	base Output
+
+	// files maps the written files to their content, and the removed ones
+	// to nil.
+	files map[string][]byte
+}
+
+func newOverlayOutput(base Output) *overlayOutput {
+	return &overlayOutput{base: base, files: make(map[string][]byte)}
+}
+
+func (o *overlayOutput) ReadFile(name string) ([]byte, error) {
+	data, ok := o.files[name]
+	if !ok {
+		return o.base.ReadFile(name)
+	}
+	if data == nil {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+	return bytes.Clone(data), nil
+}
+
+func (o *overlayOutput) WriteFile(name string, data []byte) error {
+	// A written file is never nil, even when empty.
+	o.files[name] = append([]byte{}, data...)
+	return nil
+}
+
+func (o *overlayOutput) Remove(name string) error {
+	if _, err := o.ReadFile(name); err != nil {
+		return err
+	}
+	o.files[name] = nil
+	return nil
+}
+
+func (o *overlayOutput) listFiles(dir string) ([]string, error) {
+	var names []string
+	if lister, ok := o.base.(fileLister); ok {
+		base, err := lister.listFiles(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range base {
+			if _, ok := o.files[name]; !ok {
+				names = append(names, name)
+			}
+		}
+	}
+	for name, data := range o.files {
+		if data != nil && strings.HasPrefix(name, dir+"/") {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// FileChange is a file of the output directory that Build would add, modify
+// or remove.
+type FileChange struct {
+	// Name is the file name relative to the output directory, separated by
+	// forward slashes.
+	Name string
+
+	// Old is the current content of the file, nil when it would be added.
+	Old []byte
+
+	// New is the generated content of the file, nil when it would be
+	// removed.
+	New []byte
+}
+
+// Check renders the SDK in memory and returns the files Build would change,
+// sorted by name, without writing anything.
+func (g *Generator) Check() ([]FileChange, error) {
+	base := g.out
+	overlay := newOverlayOutput(base)
+	g.out = overlay
+	defer func() {
+		g.out = base
+	}()
+
+	if err := g.Build(); err != nil {
+		return nil, err
+	}
+
+	var changes []FileChange
+	for _, name := range slices.Sorted(maps.Keys(overlay.files)) {
+		generated := overlay.files[name]
+		current, err := base.ReadFile(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			current = nil
+		} else if err != nil {
+			return nil, fmt.Errorf("read current file: %w", err)
+		}
+		if current == nil && generated == nil || current != nil && generated != nil && bytes.Equal(current, generated) {
+			continue
+		}
+		changes = append(changes, FileChange{Name: name, Old: current, New: generated})
+	}
+	return changes, nil
+}
@@ -0,0 +1,125 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// phpVersion is the PHP runtime targeted by the generated code. The schema
+// model is the same for every target, only the rendering of enums, native
+// type hints and readonly classes adapts to what the runtime supports.
+type phpVersion struct {
+	Major int
+	Minor int
+}
+
+var (
+	// defaultPHPVersion is targeted when Config.PHPVersion is empty.
+	defaultPHPVersion = phpVersion{Major: 8, Minor: 2}
+
+	// minimumPHPVersion is the oldest target, which is the first runtime
+	// with typed properties and arrow functions.
+	minimumPHPVersion = phpVersion{Major: 7, Minor: 4}
+)
+
+// parsePHPVersion parses a "major.minor" version such as "8.1". A patch
+// version is accepted and ignored.
+func parsePHPVersion(value string) (phpVersion, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return defaultPHPVersion, nil
+	}
+
+	parts := strings.Split(value, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return phpVersion{}, fmt.Errorf("invalid php version %q, expected major.minor", value)
+	}
+
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return phpVersion{}, fmt.Errorf("invalid php version %q, expected major.minor", value)
+		}
+		numbers[i] = number
+	}
+
+	version := phpVersion{Major: numbers[0], Minor: numbers[1]}
+	if !version.atLeast(minimumPHPVersion) {
+		return phpVersion{}, fmt.Errorf("php version %s is not supported, the minimum is %s", version, minimumPHPVersion)
+	}
+
+	return version, nil
+}
+
+func (v phpVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+func (v phpVersion) atLeast(other phpVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	return v.Minor >= other.Minor
+}
+
+// supportsUnionTypes reports whether union types and the mixed type can be
+// used in native type hints.
+func (v phpVersion) supportsUnionTypes() bool {
+	return v.atLeast(phpVersion{Major: 8, Minor: 0})
+}
+
+// supportsEnums reports whether enums are rendered as native enums rather
+// than classes holding a constant per case.
+func (v phpVersion) supportsEnums() bool {
+	return v.atLeast(phpVersion{Major: 8, Minor: 1})
+}
+
+// supportsReadonlyProperties reports whether properties can be readonly.
+func (v phpVersion) supportsReadonlyProperties() bool {
+	return v.atLeast(phpVersion{Major: 8, Minor: 1})
+}
+
+// supportsReadonlyClasses reports whether whole classes can be readonly.
+func (v phpVersion) supportsReadonlyClasses() bool {
+	return v.atLeast(phpVersion{Major: 8, Minor: 2})
+}
+
+// typeHint adapts a native type hint to the targeted runtime. Types that
+// cannot be expressed natively, such as unions before PHP 8.0, are dropped
+// and only documented by the docblock, in which case the result is empty.
+func (v phpVersion) typeHint(typeName string) string {
+	// null is a standalone type as of PHP 8.2 only.
+	if typeName == "null" && !v.atLeast(phpVersion{Major: 8, Minor: 2}) {
+		return ""
+	}
+	if typeName == "" || v.supportsUnionTypes() {
+		return typeName
+	}
+
+	if typeName == "mixed" {
+		return ""
+	}
+	if !strings.Contains(typeName, "|") {
+		return typeName
+	}
+
+	var types []string
+	nullable := false
+	for _, part := range strings.Split(typeName, "|") {
+		part = strings.TrimSpace(part)
+		if part == "null" {
+			nullable = true
+			continue
+		}
+		types = append(types, part)
+	}
+	if len(types) != 1 || types[0] == "mixed" {
+		return ""
+	}
+	if nullable {
+		return "?" + types[0]
+	}
+	return types[0]
+}
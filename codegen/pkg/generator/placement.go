@@ -0,0 +1,45 @@
+package generator
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// ModelPlacement selects the namespaces the model classes are generated in.
+type ModelPlacement string
+
+const (
+	// ModelPlacementTypes generates every model in SumUp\Types, one class
+	// per file.
+	ModelPlacementTypes ModelPlacement = "types"
+
+	// ModelPlacementTag generates a model used by a single tag in the file
+	// of that tag, such as SumUp\Readers\Reader, and a model used by
+	// several tags, or by none, in SumUp\Shared.
+	ModelPlacementTag ModelPlacement = "tag"
+)
+
+// parseModelPlacement validates a placement, defaulting to
+// ModelPlacementTypes.
+func parseModelPlacement(value ModelPlacement) (ModelPlacement, error) {
+	switch value {
+	case "":
+		return ModelPlacementTypes, nil
+	case ModelPlacementTypes, ModelPlacementTag:
+		return value, nil
+	default:
+		return "", fmt.Errorf("invalid model placement %q, expected %q or %q", value, ModelPlacementTypes, ModelPlacementTag)
+	}
+}
+
+// schemaTagKey returns the tag whose namespace defines the schema.
+func (g *Generator) schemaTagKey(info *schemaUsage) string {
+	if g.placement != ModelPlacementTag {
+		return typesTagKey
+	}
+	if len(info.tags) == 1 {
+		return slices.Collect(maps.Keys(info.tags))[0]
+	}
+	return sharedTagKey
+}
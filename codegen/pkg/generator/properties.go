@@ -7,6 +7,8 @@ import (
 
 	"github.com/iancoleman/strcase"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
 )
 
 type phpProperty struct {
@@ -15,7 +17,39 @@ type phpProperty struct {
 	Type           string
 	DocType        string
 	Optional       bool
-	Description    string
+	// Nullable marks properties whose schema accepts null, which are typed
+	// as nullable even when required.
+	Nullable    bool
+	Description string
+	// Default is the PHP literal used as the property and constructor
+	// default of optional properties, rendered from the schema default.
+	Default string
+	// ConstName is the class constant holding the schema const value.
+	ConstName  string
+	ConstValue string
+	lifecycle
+}
+
+// nullable reports whether the property is typed as nullable, which optional
+// properties are too.
+func (p phpProperty) nullable() bool {
+	return p.Optional || p.Nullable
+}
+
+// hasDefault reports whether the property defaults to the schema default,
+// which request payloads send only when it is changed or explicitly set.
+func (p phpProperty) hasDefault() bool {
+	return p.Default != "" && p.ConstName == ""
+}
+
+// differsFromDefault returns the PHP condition checking that a variable no
+// longer holds the default literal of its type. Integer literals are compared
+// as floats for float types, as typed properties coerce them.
+func differsFromDefault(variable, phpType, literal string) string {
+	if strings.TrimPrefix(phpType, "?") == "float" && !strings.ContainsAny(literal, ".eE") {
+		literal += ".0"
+	}
+	return fmt.Sprintf("%s !== %s", variable, literal)
 }
 
 func (g *Generator) schemaProperties(schema *base.SchemaProxy, currentNamespace string, currentClassName string) []phpProperty {
@@ -30,13 +64,23 @@ func (g *Generator) schemaProperties(schema *base.SchemaProxy, currentNamespace
 			Name:           phpPropertyName(spec.Name),
 			SerializedName: spec.Name,
 			Optional:       !spec.Required,
+			Nullable:       schemaIsNullable(spec.Schema),
 		}
 
 		if spec.Schema != nil && spec.Schema.Schema() != nil {
 			prop.Description = spec.Schema.Schema().Description
+			// A property referencing a schema inherits its status.
+			prop.lifecycle = specLifecycle(spec.Schema.Schema().Deprecated, spec.Schema.Schema().Extensions)
 		}
 
 		prop.Type, prop.DocType = g.resolvePHPType(spec.Schema, currentNamespace, currentClassName, spec.Name)
+		if value, ok := g.phpConstValue(spec.Schema, prop.Type); ok {
+			prop.ConstName = phpConstantName(spec.Name)
+			prop.ConstValue = value
+			prop.Default = "self::" + prop.ConstName
+		} else if prop.Optional {
+			prop.Default = g.phpDefaultValue(spec.Schema, prop.Type)
+		}
 		props = append(props, prop)
 	}
 
@@ -152,33 +196,18 @@ func (g *Generator) walkSchemaPropertyEntries(schema *base.SchemaProxy, stack ma
 	}
 }
 
-func (g *Generator) renderProperty(prop phpProperty) string {
-	var b strings.Builder
-
-	b.WriteString("    /**\n")
-	if prop.Description != "" {
-		for _, line := range strings.Split(prop.Description, "\n") {
-			line = strings.TrimSpace(line)
-			if line == "" {
-				continue
-			}
-			b.WriteString("     * ")
-			b.WriteString(line)
-			b.WriteString("\n")
-		}
-	}
-	b.WriteString("     *\n")
+// buildProperty declares a property. Readonly properties get no default,
+// the constructor assigns it instead.
+func (g *Generator) buildProperty(prop phpProperty, readonly bool) php.Property {
 	docType := prop.DocType
-	if prop.Optional {
+	if prop.nullable() {
 		if !strings.Contains(docType, "null") {
 			docType += "|null"
 		}
 	}
-	fmt.Fprintf(&b, "     * @var %s\n", docType)
-	b.WriteString("     */\n")
 
 	propertyType := prop.Type
-	if prop.Optional && propertyType != "mixed" && !strings.HasPrefix(propertyType, "?") {
+	if prop.nullable() && propertyType != "mixed" && !strings.HasPrefix(propertyType, "?") {
 		propertyType = "?" + propertyType
 	}
 
@@ -186,13 +215,98 @@ func (g *Generator) renderProperty(prop phpProperty) string {
 		propertyType = "mixed"
 	}
 
-	if prop.Optional {
-		fmt.Fprintf(&b, "    public %s $%s = null;\n\n", propertyType, prop.Name)
-	} else {
-		fmt.Fprintf(&b, "    public %s $%s;\n\n", propertyType, prop.Name)
+	property := php.Property{
+		Doc:  append(php.DocBlock{prop.Description, "", "@var " + docType}, prop.doc()...),
+		Type: g.php.typeHint(propertyType),
+		Name: prop.Name,
+	}
+
+	switch {
+	case readonly:
+		// Before PHP 8.2 the properties are declared readonly one by one.
+		property.Readonly = !g.php.supportsReadonlyClasses()
+	case prop.Default != "":
+		property.Default = php.Expr(prop.Default)
+	case prop.Optional:
+		property.Default = "null"
+	}
+
+	return property
+}
+
+func buildClassConstant(prop phpProperty) php.Constant {
+	return php.Constant{
+		Doc:        php.DocBlock{fmt.Sprintf("Constant value of the %s property.", prop.SerializedName)},
+		Visibility: "public",
+		Name:       prop.ConstName,
+		Value:      php.Expr(prop.ConstValue),
+	}
+}
+
+// phpDefaultValue renders the schema default as a PHP literal compatible with
+// the property type. It returns an empty string when there is no default or
+// when it cannot be represented, such as object defaults for DTO properties.
+func (g *Generator) phpDefaultValue(schema *base.SchemaProxy, phpType string) string {
+	if schema == nil || schema.Schema() == nil {
+		return ""
+	}
+	value, ok := decodeNode(schema.Schema().Default)
+	if !ok || value == nil {
+		return ""
+	}
+	return g.phpLiteralForType(value, phpType)
+}
+
+// phpConstValue renders the schema const as a PHP literal compatible with the
+// property type.
+func (g *Generator) phpConstValue(schema *base.SchemaProxy, phpType string) (string, bool) {
+	if schema == nil || schema.Schema() == nil {
+		return "", false
+	}
+	value, ok := decodeNode(schema.Schema().Const)
+	if !ok || value == nil {
+		return "", false
+	}
+	literal := g.phpLiteralForType(value, phpType)
+	return literal, literal != ""
+}
+
+func (g *Generator) phpLiteralForType(value any, phpType string) string {
+	phpType = strings.TrimPrefix(phpType, "?")
+
+	if g.enumBackingType(phpType) != "" {
+		if _, ok := value.(string); !ok {
+			return ""
+		}
+		return fmt.Sprintf("%s::%s", phpType, phpEnumCaseName(value.(string)))
+	}
+
+	switch value.(type) {
+	case string:
+		if phpType != "string" && phpType != "mixed" {
+			return ""
+		}
+	case bool:
+		if phpType != "bool" && phpType != "mixed" {
+			return ""
+		}
+	case int, int64, uint64:
+		if phpType != "int" && phpType != "float" && phpType != "mixed" {
+			return ""
+		}
+	case float64:
+		if phpType != "float" && phpType != "mixed" {
+			return ""
+		}
+	case []any, map[string]any:
+		if phpType != "array" && phpType != "mixed" {
+			return ""
+		}
+	default:
+		return ""
 	}
 
-	return b.String()
+	return renderPHPValue(value, 1)
 }
 
 func (g *Generator) resolvePHPType(schema *base.SchemaProxy, currentNamespace string, parentSchemaName string, propertyName string) (string, string) {
@@ -200,13 +314,17 @@ func (g *Generator) resolvePHPType(schema *base.SchemaProxy, currentNamespace st
 		return "mixed", "mixed"
 	}
 
+	if wrapped := schemaWrapped(schema); wrapped != nil {
+		return g.resolvePHPType(wrapped, currentNamespace, parentSchemaName, propertyName)
+	}
+
 	if ref := schema.GetReference(); ref != "" {
 		if !schemaIsObject(schema) {
 			return g.resolvePHPTypeFromSpec(schema, schema.Schema(), currentNamespace, parentSchemaName, propertyName)
 		}
 
-		if !schemaShouldGenerateClass(schema) {
-			return "array", "array<string, mixed>"
+		if !schemaShouldGenerateClass(schema) && !schemaIsMapClass(schema) {
+			return "array", g.mapDocType(schema, currentNamespace)
 		}
 
 		name := g.classNameForSchema(schema)
@@ -233,8 +351,16 @@ func (g *Generator) resolvePHPTypeFromSpec(schema *base.SchemaProxy, spec *base.
 
 	// Check if this property has an enum
 	if len(spec.Enum) > 0 && parentSchemaName != "" && propertyName != "" {
-		enumName := phpEnumName(parentSchemaName, propertyName)
+		enumName, _ := g.modelNames.lookup(enumOwner{class: parentSchemaName, property: propertyName})
 		namespace := g.enumNamespaces[enumName]
+		if namespace != "" && !g.php.supportsEnums() {
+			// Runtimes without enums carry the raw values, the enum is
+			// rendered as a class of constants instead.
+			if backingType := g.enumBackingType(enumName); backingType != "" {
+				return backingType, backingType
+			}
+			return "string", "string"
+		}
 		if namespace != "" {
 			typeName := enumName
 			if namespace != currentNamespace {
@@ -250,16 +376,32 @@ func (g *Generator) resolvePHPTypeFromSpec(schema *base.SchemaProxy, spec *base.
 		return "string", "string"
 	}
 
+	if mapped := g.mappedType(spec); mapped != "" {
+		return mapped, mapped
+	}
+
+	if len(spec.Type) == 0 {
+		if constType := schemaConstType(spec); constType != "" {
+			return constType, constType
+		}
+	}
+
 	switch {
 	case hasSchemaType(spec, "string"):
+		g.checkFormat(spec, "string")
 		return "string", "string"
 	case hasSchemaType(spec, "integer"):
+		g.checkFormat(spec, "int")
 		return "int", "int"
 	case hasSchemaType(spec, "number"):
+		g.checkFormat(spec, "float")
 		return "float", "float"
 	case hasSchemaType(spec, "boolean"):
 		return "bool", "bool"
 	case hasSchemaType(spec, "array"):
+		if len(spec.PrefixItems) > 0 {
+			return "array", g.tupleDocType(spec, currentNamespace)
+		}
 		itemDoc := "mixed"
 		if spec.Items != nil && spec.Items.A != nil {
 			_, itemDoc = g.resolvePHPType(spec.Items.A, currentNamespace, "", "")
@@ -279,17 +421,121 @@ func (g *Generator) resolvePHPTypeFromSpec(schema *base.SchemaProxy, spec *base.
 				return typeName, typeName
 			}
 		}
-		return "array", "array<string, mixed>"
+		return "array", g.mapDocType(schema, currentNamespace)
 	default:
 	}
 
 	if len(spec.OneOf) > 0 || len(spec.AnyOf) > 0 || len(spec.AllOf) > 0 {
+		g.downgradeComposition(spec)
 		return "mixed", "mixed"
 	}
 
 	return "mixed", "mixed"
 }
 
+// supportedFormats are the formats the plain type of their schema represents
+// faithfully.
+var supportedFormats = map[string]struct{}{
+	"int32": {}, "int64": {}, "float": {}, "double": {}, "byte": {}, "password": {},
+	"date": {}, "date-time": {}, "time": {}, "email": {}, "idn-email": {}, "hostname": {},
+	"idn-hostname": {}, "ipv4": {}, "ipv6": {}, "uri": {}, "uri-reference": {}, "iri": {},
+	"url": {}, "uuid": {},
+}
+
+// checkFormat records the format of a scalar schema generated as phpType
+// when the type does not represent it faithfully, such as a decimal
+// generated as a float. Formats mapped through Config.TypeMappings are
+// supported.
+func (g *Generator) checkFormat(spec *base.Schema, phpType string) {
+	format := schemaFormat(spec)
+	if format == "" {
+		return
+	}
+	if _, ok := supportedFormats[format]; ok {
+		return
+	}
+	g.downgrade(DowngradeFormat, g.schemaPointer(spec), spec, "format %q is generated as %s", format, phpType)
+}
+
+// schemaFormat returns the format of the schema. The contentEncoding and
+// contentMediaType of OpenAPI 3.1 stand for the byte and binary formats of
+// 3.0.
+func schemaFormat(spec *base.Schema) string {
+	switch {
+	case spec.Format != "":
+		return spec.Format
+	case spec.ContentEncoding == "base64":
+		return "byte"
+	case spec.ContentMediaType != "":
+		return "binary"
+	}
+	return ""
+}
+
+// schemaConstType returns the PHP type of the const value of an untyped
+// schema, if any.
+func schemaConstType(spec *base.Schema) string {
+	value, ok := decodeNode(spec.Const)
+	if !ok {
+		return ""
+	}
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int, int64, uint64:
+		return "int"
+	case float64:
+		return "float"
+	}
+	return ""
+}
+
+// tupleDocType renders the docblock type of an array schema with prefixItems
+// as an array shape. Objects are kept as payload arrays within tuples, typed
+// as mixed.
+func (g *Generator) tupleDocType(spec *base.Schema, currentNamespace string) string {
+	if spec.Items != nil && spec.Items.A != nil {
+		return "mixed[]"
+	}
+
+	members := make([]string, 0, len(spec.PrefixItems))
+	for _, member := range spec.PrefixItems {
+		doc := "mixed"
+		if !schemaIsObject(member) {
+			_, doc = g.resolvePHPType(member, currentNamespace, "", "")
+		}
+		members = append(members, doc)
+	}
+	return "array{" + strings.Join(members, ", ") + "}"
+}
+
+// downgradeComposition records a composition without type generated as
+// mixed.
+func (g *Generator) downgradeComposition(spec *base.Schema) {
+	keyword := "allOf"
+	switch {
+	case len(spec.OneOf) > 0:
+		keyword = "oneOf"
+	case len(spec.AnyOf) > 0:
+		keyword = "anyOf"
+	}
+	g.downgrade(DowngradeComposition, g.schemaPointer(spec), spec, "%s schema is generated as mixed", keyword)
+}
+
+// mapDocType renders the docblock type of an object schema kept as a PHP
+// array, typing the values when additionalProperties describes them.
+func (g *Generator) mapDocType(schema *base.SchemaProxy, currentNamespace string) string {
+	values := schemaMapValues(schema)
+	if values == nil {
+		return "array<string, mixed>"
+	}
+
+	_, valueDoc := g.resolvePHPType(values, currentNamespace, "", "")
+	return fmt.Sprintf("array<string, %s>", valueDoc)
+}
+
 func hasSchemaType(schema *base.Schema, typ string) bool {
 	if schema == nil {
 		return false
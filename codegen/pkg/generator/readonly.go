@@ -0,0 +1,257 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
+)
+
+// collectReadonlyClassNames returns the response-only classes, which are
+// neither maps nor reachable from a request body. PHP requires the parent and
+// children of a readonly class to be readonly as well, so classes related to
+// a mutable one are left mutable.
+func (g *Generator) collectReadonlyClassNames() map[string]struct{} {
+	schemasByName := make(map[string]*base.SchemaProxy)
+	for _, schemas := range g.schemasByTag {
+		for _, schema := range schemas {
+			if name := g.classNameForSchema(schema); name != "" {
+				schemasByName[name] = schema
+			}
+		}
+	}
+
+	requestReachable := make(map[string]struct{})
+	var visit func(name string, schema *base.SchemaProxy, namespace string)
+	visitReference := func(typeName string) {
+		name := phpClassBaseName(typeName)
+		if schema, ok := schemasByName[name]; ok {
+			visit(name, schema, g.schemaNamespaces[name])
+		}
+	}
+	visit = func(name string, schema *base.SchemaProxy, namespace string) {
+		if _, seen := requestReachable[name]; seen {
+			return
+		}
+		requestReachable[name] = struct{}{}
+		if parent := schemaBaseClass(schema); parent != nil {
+			visitReference(g.classNameForSchema(parent))
+		}
+		for _, prop := range g.classProperties(name, schema, namespace) {
+			visitReference(strings.TrimPrefix(prop.Type, "?"))
+			visitReference(docItemType(prop.DocType))
+		}
+	}
+	for name, schema := range schemasByName {
+		if g.shouldGenerateConstructorForClass(name) {
+			visit(name, schema, g.schemaNamespaces[name])
+		}
+	}
+	for tagKey, operations := range g.operationsByTag {
+		for _, op := range operations {
+			if op != nil && op.BodySchema != nil && shouldGenerateRequestBodyClass(op) {
+				visit(g.requestBodyClassName(g.displayTagName(tagKey), op), op.BodySchema, g.servicesNamespace())
+			}
+		}
+	}
+
+	result := make(map[string]struct{})
+	parents := make(map[string]string)
+	for name, schema := range schemasByName {
+		if _, ok := requestReachable[name]; ok || schemaIsMapClass(schema) {
+			continue
+		}
+		result[name] = struct{}{}
+		if parent := schemaBaseClass(schema); parent != nil {
+			parents[name] = g.classNameForSchema(parent)
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for child, parent := range parents {
+			_, childReadonly := result[child]
+			_, parentReadonly := result[parent]
+			if childReadonly == parentReadonly {
+				continue
+			}
+			delete(result, child)
+			delete(result, parent)
+			changed = true
+		}
+	}
+
+	return result
+}
+
+// markReadonlyInlineSchemas marks the inline classes of response bodies as
+// readonly, unless a request body shares them or their parent is mutable.
+func (g *Generator) markReadonlyInlineSchemas(responseSchemas, requestSchemas map[string]*base.SchemaProxy) {
+	for name, schema := range responseSchemas {
+		if _, ok := requestSchemas[name]; ok || schemaIsMapClass(schema) {
+			continue
+		}
+		if parent := schemaBaseClass(schema); parent != nil && !g.isReadonlyClass(g.classNameForSchema(parent)) {
+			continue
+		}
+		g.readonlyClassNames[name] = struct{}{}
+	}
+}
+
+func (g *Generator) isReadonlyClass(className string) bool {
+	_, ok := g.readonlyClassNames[className]
+	return ok
+}
+
+// buildReadonlyMethods builds the constructor, accessors and withers of a
+// readonly class. Readonly properties can only be initialized once and from
+// the declaring class, so the constructor collects the payload first and
+// leaves inherited properties to the parent constructor.
+func (g *Generator) buildReadonlyMethods(properties []phpProperty, ownProperties []phpProperty, root bool) []php.Method {
+	methods := []php.Method{
+		g.buildReadonlyConstructor(ownProperties, root),
+		{
+			Doc: php.DocBlock{
+				"Create an instance from an associative array payload.",
+				"",
+				"@param array<string, mixed> $data",
+			},
+			Static:     true,
+			Name:       "fromArray",
+			Params:     []php.Param{{Type: "array", Name: "data"}},
+			ReturnType: "self",
+			Body:       "return new self($data);",
+		},
+	}
+
+	if root {
+		methods = append(methods, php.Method{
+			Doc: php.DocBlock{
+				"Payload fields without a matching property, keyed by their serialized name.",
+				"",
+				"@return array<string, mixed>",
+			},
+			Name:       "additionalProperties",
+			ReturnType: "array",
+			Body:       "return $this->additionalProperties;",
+		})
+	}
+
+	methods = append(methods, g.buildToArrayMethod(properties, false, false))
+
+	for _, prop := range properties {
+		param := g.constructorParam(prop)
+		param.Default = ""
+		methods = append(methods, php.Method{
+			Doc: append(php.DocBlock{
+				fmt.Sprintf("Return a copy with %s replaced.", prop.Name),
+				"",
+				fmt.Sprintf("@param %s $%s", g.constructorParamDocType(prop), prop.Name),
+			}, prop.doc()...),
+			Name:       phpWitherName(prop.Name),
+			Params:     []php.Param{param},
+			ReturnType: "self",
+			Body:       g.deprecationTrigger("$"+prop.Name, prop.lifecycle) + fmt.Sprintf("return $this->with(%s, $%s);", phpString(prop.Name), prop.Name),
+		})
+	}
+
+	var body strings.Builder
+	body.WriteString("$data = get_object_vars($this);\n")
+	body.WriteString("unset($data['additionalProperties']);\n")
+	body.WriteString("$data[$propertyName] = $value;\n\n")
+	body.WriteString("return new self($data + $this->additionalProperties());\n")
+	methods = append(methods, php.Method{
+		Doc:        php.DocBlock{"Create a copy with a single property replaced."},
+		Visibility: "private",
+		Name:       "with",
+		Params: []php.Param{
+			{Type: "string", Name: "propertyName"},
+			{Type: "mixed", Name: "value"},
+		},
+		ReturnType: "self",
+		Body:       body.String(),
+	})
+
+	return methods
+}
+
+// readonlyAdditionalProperties is the bag of unknown payload fields of a root
+// readonly class, which cannot use the mutable AdditionalPropertiesTrait.
+func (g *Generator) readonlyAdditionalProperties() php.Property {
+	return php.Property{
+		Doc: php.DocBlock{
+			"Payload fields without a matching property.",
+			"",
+			"@var array<string, mixed>",
+		},
+		Visibility: "private",
+		// Before PHP 8.2 the properties are declared readonly one by one.
+		Readonly: !g.php.supportsReadonlyClasses(),
+		Type:     "array",
+		Name:     "additionalProperties",
+	}
+}
+
+func (g *Generator) buildReadonlyConstructor(ownProperties []phpProperty, root bool) php.Method {
+	constructor := php.Method{
+		Doc:        php.DocBlock{"@param array<string, mixed> $data"},
+		Visibility: "protected",
+		Name:       "__construct",
+		Params:     []php.Param{{Type: "array", Name: "data"}},
+	}
+
+	if len(ownProperties) == 0 {
+		if root {
+			constructor.Body = "$this->additionalProperties = $data;"
+		} else {
+			constructor.Body = "parent::__construct($data);"
+		}
+		return constructor
+	}
+
+	remainder := "$additionalProperties"
+	if !root {
+		remainder = "$inherited"
+	}
+
+	var body strings.Builder
+	body.WriteString("$values = [];\n")
+	fmt.Fprintf(&body, "%s = [];\n", remainder)
+	body.WriteString("foreach ($data as $key => $value) {\n")
+	body.WriteString("    switch ($key) {\n")
+	for _, prop := range ownProperties {
+		fmt.Fprintf(&body, "        case %s:\n", phpString(prop.SerializedName))
+		if prop.Name != prop.SerializedName {
+			fmt.Fprintf(&body, "        case %s:\n", phpString(prop.Name))
+		}
+		fmt.Fprintf(&body, "            $values[%s] = %s;\n", phpString(prop.Name), g.hydrateExpression(prop.Type, prop.DocType, "$value", prop.nullable()))
+		body.WriteString("            break;\n")
+	}
+	body.WriteString("        default:\n")
+	fmt.Fprintf(&body, "            %s[$key] = $value;\n", remainder)
+	body.WriteString("    }\n")
+	body.WriteString("}\n\n")
+
+	if !root {
+		body.WriteString("parent::__construct($inherited);\n")
+	}
+	for _, prop := range ownProperties {
+		key := phpString(prop.Name)
+		if prop.Optional || prop.Default != "" {
+			fmt.Fprintf(&body, "$this->%s = array_key_exists(%s, $values) ? $values[%s] : %s;\n", prop.Name, key, key, constructorParamDefault(prop))
+			continue
+		}
+		// Required properties missing from the payload stay uninitialized.
+		fmt.Fprintf(&body, "if (array_key_exists(%s, $values)) {\n", key)
+		fmt.Fprintf(&body, "    $this->%s = $values[%s];\n", prop.Name, key)
+		body.WriteString("}\n")
+	}
+	if root {
+		body.WriteString("$this->additionalProperties = $additionalProperties;\n")
+	}
+	constructor.Body = body.String()
+
+	return constructor
+}
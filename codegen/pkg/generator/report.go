@@ -0,0 +1,207 @@
+package generator
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
+)
+
+// DowngradeKind classifies the constructs of the specs the generator does
+// not render faithfully.
+type DowngradeKind string
+
+const (
+	// DowngradeComposition is a oneOf, anyOf or allOf schema without type
+	// generated as mixed.
+	DowngradeComposition DowngradeKind = "composition"
+	// DowngradeParameter is a header or cookie parameter the service methods
+	// do not accept.
+	DowngradeParameter DowngradeKind = "parameter"
+	// DowngradeUntaggedOperation is an operation without tags, which no
+	// service generates.
+	DowngradeUntaggedOperation DowngradeKind = "untagged_operation"
+	// DowngradeNonJSONBody is a request body encoded as JSON although it
+	// declares another media type, or a response body that is ignored.
+	DowngradeNonJSONBody DowngradeKind = "non_json_body"
+	// DowngradeFormat is a format generated as the plain type of its schema.
+	DowngradeFormat DowngradeKind = "unsupported_format"
+)
+
+// Downgrade is a construct of the specs the generator does not render
+// faithfully.
+type Downgrade struct {
+	Kind DowngradeKind `json:"kind"`
+	// Pointer is the JSON pointer of the construct, such as a schema, or of
+	// its operation.
+	Pointer string `json:"pointer,omitempty"`
+	// Line and Column locate the construct in the specs.
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+}
+
+func (d Downgrade) String() string {
+	location := fmt.Sprintf("%d:%d", d.Line, d.Column)
+	if d.Pointer != "" {
+		location += " " + d.Pointer
+	}
+	return fmt.Sprintf("%s: %s: %s", location, d.Kind, d.Message)
+}
+
+// Report describes the output of the last Build: the files written, the
+// fully qualified names of the declared classes, interfaces, enums and
+// services, and the constructs of the specs that were downgraded.
+type Report struct {
+	Files      []string    `json:"files"`
+	Classes    []string    `json:"classes"`
+	Interfaces []string    `json:"interfaces"`
+	Enums      []string    `json:"enums"`
+	Services   []string    `json:"services"`
+	Downgrades []Downgrade `json:"downgrades"`
+}
+
+// Report returns the report of the last Build. Downgrades found while loading
+// the specs are included.
+func (g *Generator) Report() Report {
+	report := g.emitted
+	report.Files = slices.Sorted(maps.Keys(g.generated.Files))
+	for _, names := range []*[]string{&report.Files, &report.Classes, &report.Interfaces, &report.Enums, &report.Services} {
+		if *names == nil {
+			*names = []string{}
+		}
+		slices.Sort(*names)
+	}
+
+	report.Downgrades = slices.Collect(maps.Keys(g.downgrades))
+	slices.SortFunc(report.Downgrades, func(a, b Downgrade) int {
+		return cmp.Or(
+			cmp.Compare(a.Line, b.Line),
+			cmp.Compare(a.Column, b.Column),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
+	if report.Downgrades == nil {
+		report.Downgrades = []Downgrade{}
+	}
+	return report
+}
+
+// downgrade records a construct of the specs the generator does not render
+// faithfully. Each construct is recorded once, however many times it is
+// rendered.
+func (g *Generator) downgrade(kind DowngradeKind, pointer string, model lowModel, format string, args ...any) {
+	line, column := sourceLocation(model)
+	g.downgrades[Downgrade{
+		Kind:    kind,
+		Pointer: pointer,
+		Line:    line,
+		Column:  column,
+		Message: fmt.Sprintf(format, args...),
+	}] = struct{}{}
+}
+
+// schemaPointer returns the JSON pointer of a schema in the specs, or an
+// empty string when it is not part of the loaded document.
+func (g *Generator) schemaPointer(spec *base.Schema) string {
+	low := spec.GoLow()
+	if low == nil || low.RootNode == nil {
+		return ""
+	}
+	if g.nodePointers == nil {
+		g.nodePointers = make(map[*yaml.Node]string)
+		if document := g.spec.GoLow(); document != nil && document.Index != nil {
+			indexNodePointers(document.Index.GetRootNode(), "", g.nodePointers)
+		}
+	}
+	return g.nodePointers[low.RootNode]
+}
+
+// indexNodePointers maps the nodes of a YAML tree to their JSON pointer. A
+// node reachable through aliases keeps its first pointer.
+func indexNodePointers(node *yaml.Node, pointer string, pointers map[*yaml.Node]string) {
+	if node == nil {
+		return
+	}
+	if _, ok := pointers[node]; ok {
+		return
+	}
+	pointers[node] = pointer
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			indexNodePointers(child, pointer, pointers)
+		}
+	case yaml.MappingNode:
+		for idx := 0; idx+1 < len(node.Content); idx += 2 {
+			indexNodePointers(node.Content[idx+1], pointer+jsonPointer(node.Content[idx].Value), pointers)
+		}
+	case yaml.SequenceNode:
+		for idx, child := range node.Content {
+			indexNodePointers(child, pointer+jsonPointer(strconv.Itoa(idx)), pointers)
+		}
+	}
+}
+
+// addEmitted records the declarations of a written PHP file in the report.
+func (g *Generator) addEmitted(file *php.File) {
+	for _, namespace := range file.Namespaces {
+		for _, decl := range namespace.Decls {
+			switch d := decl.(type) {
+			case *php.Class:
+				name := namespace.Name + `\` + d.Name
+				switch {
+				case slices.Contains(d.Implements, g.serviceName("SumUpService")):
+					g.emitted.Services = append(g.emitted.Services, name)
+				case g.enumNamespaces[d.Name] == namespace.Name:
+					// Enums are rendered as classes of constants on runtimes
+					// lacking them.
+					g.emitted.Enums = append(g.emitted.Enums, name)
+				default:
+					g.emitted.Classes = append(g.emitted.Classes, name)
+				}
+			case *php.Interface:
+				g.emitted.Interfaces = append(g.emitted.Interfaces, namespace.Name+`\`+d.Name)
+			case *php.Enum:
+				g.emitted.Enums = append(g.emitted.Enums, namespace.Name+`\`+d.Name)
+			}
+		}
+	}
+}
+
+// lowModel is a model of the specs tracking its source, such as a
+// *base.Schema or a *v3.Operation.
+type lowModel interface {
+	GoLowUntyped() any
+}
+
+// sourceLocation returns the line and column of a model in the specs, or
+// zeros when unknown.
+func sourceLocation(model lowModel) (int, int) {
+	if model == nil {
+		return 0, 0
+	}
+
+	var node *yaml.Node
+	switch low := model.GoLowUntyped().(type) {
+	case *lowbase.Schema:
+		if low != nil {
+			node = low.RootNode
+		}
+	case interface{ GetRootNode() *yaml.Node }:
+		node = low.GetRootNode()
+	}
+	if node == nil {
+		return 0, 0
+	}
+	return node.Line, node.Column
+}
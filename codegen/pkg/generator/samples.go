@@ -81,7 +81,7 @@ func (g *Generator) Samples(sdkVersion string) (*SampleCatalog, error) {
 		slices.Sort(methods)
 		for _, method := range methods {
 			specOperation, ok := operations.Get(method)
-			if !ok || specOperation == nil {
+			if !ok || !g.includesOperation(specOperation) {
 				continue
 			}
 			if specOperation.OperationId == "" {
@@ -237,7 +237,7 @@ func (g *Generator) renderSample(
 	var body strings.Builder
 	body.WriteString("<?php\n\ndeclare(strict_types=1);\n\n")
 	body.WriteString("require __DIR__ . '/vendor/autoload.php';\n\n")
-	body.WriteString("$sumup = new \\SumUp\\SumUp('sup_sk_your_api_key');\n")
+	fmt.Fprintf(&body, "$sumup = new %s('sup_sk_your_api_key');\n", g.runtimeName("SumUp"))
 
 	usesQueryParams := false
 	if built.HasQuery {
@@ -273,8 +273,8 @@ func (g *Generator) renderSample(
 		}
 		if len(assignments) > 0 {
 			usesQueryParams = true
-			paramsClass := queryParamsClassName(serviceClass, built)
-			fmt.Fprintf(&body, "\n$queryParams = new \\SumUp\\Services\\%s();\n", paramsClass)
+			paramsClass := g.queryParamsClassName(serviceClass, built)
+			fmt.Fprintf(&body, "\n$queryParams = new %s();\n", g.serviceName(paramsClass))
 			for _, assignment := range assignments {
 				body.WriteString(assignment)
 			}
@@ -370,19 +370,25 @@ func schemaExample(schema *base.SchemaProxy) (any, bool) {
 		return nil, false
 	}
 	spec := schema.Schema()
-	if value, provided := decodeNode(spec.Example); provided {
+	if value, provided := decodeNode(spec.Const); provided {
 		return value, true
 	}
-	if value, provided := decodeNode(spec.Default); provided {
+	// OpenAPI 3.1 deprecates example in favour of examples.
+	if value, provided := decodeNode(spec.Example); provided {
 		return value, true
 	}
-	if len(spec.Examples) > 0 {
-		if value, provided := decodeNode(spec.Examples[0]); provided {
+	for _, example := range spec.Examples {
+		if value, provided := decodeNode(example); provided {
 			return value, true
 		}
 	}
-	if len(spec.Enum) > 0 {
-		return decodeNode(spec.Enum[0])
+	if value, provided := decodeNode(spec.Default); provided {
+		return value, true
+	}
+	for _, value := range spec.Enum {
+		if value != nil && value.ShortTag() != "!!null" {
+			return decodeNode(value)
+		}
 	}
 	return nil, false
 }
@@ -412,6 +418,9 @@ func exampleForSchema(schema *base.SchemaProxy, visited map[*base.SchemaProxy]st
 	defer delete(visited, schema)
 
 	spec := schema.Schema()
+	if wrapped := schemaWrapped(schema); wrapped != nil {
+		return exampleForSchema(wrapped, visited)
+	}
 	if len(spec.OneOf) > 0 {
 		return exampleForSchema(spec.OneOf[0], visited)
 	}
@@ -447,6 +456,13 @@ func exampleForSchema(schema *base.SchemaProxy, visited map[*base.SchemaProxy]st
 		return value
 	}
 	if hasSchemaType(spec, "array") {
+		if len(spec.PrefixItems) > 0 {
+			value := make([]any, 0, len(spec.PrefixItems))
+			for _, member := range spec.PrefixItems {
+				value = append(value, exampleForSchema(member, visited))
+			}
+			return value
+		}
 		if spec.Items != nil && spec.Items.A != nil {
 			return []any{exampleForSchema(spec.Items.A, visited)}
 		}
@@ -505,7 +521,7 @@ func renderPHPValue(value any, indent int) string {
 	case nil:
 		return "null"
 	case string:
-		return "'" + strings.ReplaceAll(strings.ReplaceAll(typed, "\\", "\\\\"), "'", "\\'") + "'"
+		return phpString(typed)
 	case bool:
 		return strconv.FormatBool(typed)
 	case int:
@@ -9,7 +9,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/pb33f/libopenapi"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
 	"go.yaml.in/yaml/v4"
@@ -132,17 +131,13 @@ func testSampleCatalog(t *testing.T) (*SampleCatalog, int) {
 	if err != nil {
 		t.Fatalf("read OpenAPI document: %v", err)
 	}
-	document, err := libopenapi.NewDocument(spec)
+	model, err := ParseSpecs(spec)
 	if err != nil {
-		t.Fatalf("load OpenAPI document: %v", err)
-	}
-	model, err := document.BuildV3Model()
-	if err != nil {
-		t.Fatalf("build OpenAPI model: %v", err)
+		t.Fatalf("parse specs: %v", err)
 	}
 
-	g := New(Config{})
-	if err := g.Load(&model.Model); err != nil {
+	g := New(Config{IncludeBeta: true})
+	if err := g.Load(model); err != nil {
 		t.Fatalf("load generator: %v", err)
 	}
 	catalog, err := g.Samples("test")
@@ -150,7 +145,7 @@ func testSampleCatalog(t *testing.T) (*SampleCatalog, int) {
 		t.Fatalf("generate samples: %v", err)
 	}
 	expectedSamples := 0
-	for _, pathItem := range model.Model.Paths.PathItems.FromOldest() {
+	for _, pathItem := range model.Paths.PathItems.FromOldest() {
 		for _, operation := range pathItem.GetOperations().FromOldest() {
 			expectedSamples += len(requestExamples(operation))
 		}
@@ -1,6 +1,10 @@
 package generator
 
-import "github.com/pb33f/libopenapi/datamodel/high/base"
+import (
+	"slices"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
 
 // schemaIsObject reports whether the provided schema describes an object that
 // warrants generating a PHP class. It walks nested compositions (allOf/oneOf/anyOf)
@@ -10,7 +14,8 @@ func schemaIsObject(schema *base.SchemaProxy) bool {
 }
 
 // schemaIsAdditionalPropertiesOnly checks if a schema is an object with only
-// additionalProperties defined (no explicit properties). These should extend ArrayObject.
+// additionalProperties defined (no explicit properties). These are maps rather
+// than DTOs; see schemaMapValues for maps with typed values.
 func schemaIsAdditionalPropertiesOnly(schema *base.SchemaProxy) bool {
 	if schema == nil {
 		return false
@@ -137,3 +142,131 @@ func schemaIsObjectWithStack(schema *base.SchemaProxy, stack map[*base.SchemaPro
 
 	return false
 }
+
+// schemaParentRefs returns the referenced object schemas an allOf composition
+// is built from. Only schemas that generate a PHP class qualify as parents.
+func schemaParentRefs(schema *base.SchemaProxy) []*base.SchemaProxy {
+	if schema == nil || schema.Schema() == nil {
+		return nil
+	}
+
+	parents := make([]*base.SchemaProxy, 0)
+	for _, composite := range schema.Schema().AllOf {
+		if composite == nil || composite.GetReference() == "" {
+			continue
+		}
+		if !schemaShouldGenerateClass(composite) {
+			continue
+		}
+		parents = append(parents, composite)
+	}
+
+	return parents
+}
+
+// schemaBaseClass returns the parent schema a class extends, which is the
+// single referenced object schema of its allOf composition.
+func schemaBaseClass(schema *base.SchemaProxy) *base.SchemaProxy {
+	parents := schemaParentRefs(schema)
+	if len(parents) != 1 {
+		return nil
+	}
+	return parents[0]
+}
+
+// schemaMapValues returns the value schema of an additionalProperties-only
+// object whose values are described by a typed schema, such as
+// `additionalProperties: {type: string}`. Untyped maps return nil.
+func schemaMapValues(schema *base.SchemaProxy) *base.SchemaProxy {
+	if !schemaIsAdditionalPropertiesOnly(schema) {
+		return nil
+	}
+
+	additional := schema.Schema().AdditionalProperties
+	if !additional.IsA() || additional.A == nil {
+		return nil
+	}
+
+	values := additional.A
+	if values.GetReference() != "" {
+		return values
+	}
+
+	spec := values.Schema()
+	if spec == nil {
+		return nil
+	}
+	if len(spec.Type) == 0 && len(spec.Enum) == 0 && spec.Properties == nil &&
+		len(spec.AllOf) == 0 && len(spec.AnyOf) == 0 && len(spec.OneOf) == 0 {
+		return nil
+	}
+
+	return values
+}
+
+// schemaIsMapClass reports whether the schema is a named map with typed
+// values, which is generated as an ArrayObject subclass.
+func schemaIsMapClass(schema *base.SchemaProxy) bool {
+	return schema != nil && schema.GetReference() != "" && schemaMapValues(schema) != nil
+}
+
+// schemaIsNullable reports whether the schema accepts null, through
+// `nullable` in OpenAPI 3.0, a null type in 3.1 or a member of its wrapper.
+func schemaIsNullable(schema *base.SchemaProxy) bool {
+	if schema == nil || schema.Schema() == nil {
+		return false
+	}
+
+	spec := schema.Schema()
+	if spec.Nullable != nil && *spec.Nullable || hasSchemaType(spec, "null") {
+		return true
+	}
+	if schemaWrapped(schema) == nil {
+		return false
+	}
+	return slices.ContainsFunc(spec.AnyOf, schemaIsNull) || slices.ContainsFunc(spec.OneOf, schemaIsNull)
+}
+
+// schemaWrapped returns the schema an inline schema merely wraps, or nil: the
+// single member of an allOf, as OpenAPI 3.0 makes a reference nullable, or the
+// member of an anyOf or oneOf pairing a schema with null, as 3.1 does.
+func schemaWrapped(schema *base.SchemaProxy) *base.SchemaProxy {
+	if schema == nil || schema.GetReference() != "" || schema.Schema() == nil {
+		return nil
+	}
+
+	spec := schema.Schema()
+	if len(spec.Type) > 0 || spec.Properties != nil && spec.Properties.Len() > 0 || spec.AdditionalProperties != nil {
+		return nil
+	}
+
+	switch {
+	case len(spec.AllOf) == 1 && len(spec.AnyOf) == 0 && len(spec.OneOf) == 0:
+		return spec.AllOf[0]
+	case len(spec.AllOf) == 0 && len(spec.AnyOf) == 2 && len(spec.OneOf) == 0:
+		return nonNullMember(spec.AnyOf)
+	case len(spec.AllOf) == 0 && len(spec.AnyOf) == 0 && len(spec.OneOf) == 2:
+		return nonNullMember(spec.OneOf)
+	}
+	return nil
+}
+
+// nonNullMember returns the other member of a pair of schemas, one of which
+// only accepts null.
+func nonNullMember(members []*base.SchemaProxy) *base.SchemaProxy {
+	switch {
+	case schemaIsNull(members[0]) && !schemaIsNull(members[1]):
+		return members[1]
+	case schemaIsNull(members[1]) && !schemaIsNull(members[0]):
+		return members[0]
+	}
+	return nil
+}
+
+// schemaIsNull reports whether the schema only accepts null.
+func schemaIsNull(schema *base.SchemaProxy) bool {
+	if schema == nil || schema.Schema() == nil {
+		return false
+	}
+	return slices.Equal(schema.Schema().Type, []string{"null"})
+}
@@ -0,0 +1,293 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
+)
+
+// buildArrayMethods builds the reflection-free fromArray(), fill() and
+// toArray() methods of a class. The field names, casts and item classes are
+// resolved at generation time, so that neither the Hydrator nor the
+// RequestEncoder has to inspect the class at runtime.
+func (g *Generator) buildArrayMethods(className string, schema *base.SchemaProxy, properties []phpProperty, tracksExplicitFields bool) []php.Method {
+	var methods []php.Method
+
+	// Request DTOs render their own fromArray() along with the constructor.
+	if !g.shouldGenerateConstructorForClass(className) {
+		var required []phpProperty
+		if parent := g.constructorBaseClass(schema); parent != nil {
+			required = inheritedRequiredProperties(properties, g.collectSchemaPropertyEntries(parent))
+		}
+		var body strings.Builder
+		body.WriteString(g.newInstanceStatement("$instance", required))
+		body.WriteString("$instance->fill($data);\n\n")
+		body.WriteString("return $instance;\n")
+		methods = append(methods, php.Method{
+			Doc: php.DocBlock{
+				"Create an instance from an associative array payload.",
+				"",
+				"@param array<string, mixed> $data",
+			},
+			Static:     true,
+			Name:       "fromArray",
+			Params:     []php.Param{{Type: "array", Name: "data"}},
+			ReturnType: "self",
+			Body:       body.String(),
+		})
+		if len(required) > 0 {
+			methods = append(methods, buildRequiredFieldsMethod())
+		}
+	}
+
+	methods = append(methods, g.buildFillMethod(properties))
+	methods = append(methods, g.buildToArrayMethod(properties, tracksExplicitFields, g.shouldGenerateConstructorForClass(className)))
+
+	return methods
+}
+
+func (g *Generator) buildFillMethod(properties []phpProperty) php.Method {
+	method := php.Method{
+		Doc: php.DocBlock{
+			"Assign the known fields of a payload, keeping the others as additional properties.",
+			"",
+			"@param array<string, mixed> $data",
+		},
+		Visibility: "private",
+		Name:       "fill",
+		Params:     []php.Param{{Type: "array", Name: "data"}},
+		ReturnType: "void",
+	}
+	if len(properties) == 0 {
+		method.Body = "$this->setAdditionalProperties(array_merge($this->additionalProperties(), $data));"
+		return method
+	}
+
+	var body strings.Builder
+	body.WriteString("$additionalProperties = [];\n")
+	body.WriteString("foreach ($data as $key => $value) {\n")
+	body.WriteString("    switch ($key) {\n")
+	for _, prop := range properties {
+		fmt.Fprintf(&body, "        case %s:\n", phpString(prop.SerializedName))
+		if prop.Name != prop.SerializedName {
+			fmt.Fprintf(&body, "        case %s:\n", phpString(prop.Name))
+		}
+		fmt.Fprintf(&body, "            $this->%s = %s;\n", prop.Name, g.hydrateExpression(prop.Type, prop.DocType, "$value", prop.nullable()))
+		body.WriteString("            break;\n")
+	}
+	body.WriteString("        default:\n")
+	body.WriteString("            $additionalProperties[$key] = $value;\n")
+	body.WriteString("    }\n")
+	body.WriteString("}\n\n")
+	body.WriteString("if ($additionalProperties !== []) {\n")
+	body.WriteString("    $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));\n")
+	body.WriteString("}\n")
+	method.Body = body.String()
+
+	return method
+}
+
+// buildToArrayMethod builds toArray(). Request payloads omit the defaults of
+// the specs left untouched, so that the server applies its own defaults.
+func (g *Generator) buildToArrayMethod(properties []phpProperty, tracksExplicitFields, omitsDefaults bool) php.Method {
+	method := php.Method{
+		Doc: php.DocBlock{
+			"Convert the instance into a payload, omitting unset fields.",
+			"",
+			"@return array<string, mixed>",
+		},
+		Name:       "toArray",
+		ReturnType: "array",
+	}
+	if len(properties) == 0 {
+		method.Body = "return $this->additionalProperties();"
+		return method
+	}
+
+	var body strings.Builder
+	body.WriteString("$data = [];\n")
+	for _, prop := range properties {
+		key := phpString(prop.SerializedName)
+		if omitsDefaults && prop.hasDefault() {
+			fmt.Fprintf(&body, "if (isset($this->%s) && (%s || isset($this->explicitFields[%s]))) {\n",
+				prop.Name, differsFromDefault("$this->"+prop.Name, prop.Type, prop.Default), phpString(prop.Name))
+		} else {
+			fmt.Fprintf(&body, "if (isset($this->%s)) {\n", prop.Name)
+		}
+		fmt.Fprintf(&body, "    $data[%s] = %s;\n", key, g.serializeExpression(prop.Type, prop.DocType, "$this->"+prop.Name))
+		if tracksExplicitFields {
+			fmt.Fprintf(&body, "} elseif (isset($this->explicitFields[%s])) {\n", phpString(prop.Name))
+			fmt.Fprintf(&body, "    $data[%s] = null;\n", key)
+		}
+		body.WriteString("}\n")
+	}
+	body.WriteString("\n")
+	body.WriteString("return $data + $this->additionalProperties();\n")
+	method.Body = body.String()
+
+	return method
+}
+
+// buildMapArrayMethods builds fromArray() and toArray() for a map class.
+func (g *Generator) buildMapArrayMethods(valueType string) []php.Method {
+	fromArray := php.Method{
+		Doc: php.DocBlock{
+			"Create a map from an associative array payload.",
+			"",
+			"@param array<string, mixed> $data",
+		},
+		Static:     true,
+		Name:       "fromArray",
+		Params:     []php.Param{{Type: "array", Name: "data"}},
+		ReturnType: "self",
+		Body:       "return new self($data);",
+	}
+	if item := g.hydrateExpression(valueType, valueType, "$item", false); item != "$item" {
+		fromArray.Body = fmt.Sprintf("return new self(array_map(static fn ($item) => %s, $data));", item)
+	}
+
+	toArray := php.Method{
+		Doc: php.DocBlock{
+			"Convert the map into a payload.",
+			"",
+			"@return array<string, mixed>",
+		},
+		Name:       "toArray",
+		ReturnType: "array",
+		Body:       "return $this->getArrayCopy();",
+	}
+	if item := g.serializeExpression(valueType, valueType, "$item"); item != "$item" {
+		toArray.Body = fmt.Sprintf("return array_map(static fn (%s $item) => %s, $this->getArrayCopy());", valueType, item)
+	}
+
+	return []php.Method{fromArray, toArray}
+}
+
+// phpString renders a string literal for generated code.
+func phpString(value string) string {
+	return string(php.String(value))
+}
+
+// hydrateExpression returns the PHP expression converting the payload value
+// in variable into a value of phpType. Null is only kept for nullable types.
+func (g *Generator) hydrateExpression(phpType, docType, variable string, nullable bool) string {
+	typeName := strings.TrimPrefix(phpType, "?")
+	nullable = nullable || strings.HasPrefix(phpType, "?")
+
+	cast := ""
+	switch typeName {
+	case "", "mixed":
+		return variable
+	case "string", "int", "float", "bool":
+		cast = fmt.Sprintf("(%s) %s", typeName, variable)
+	case "array":
+		itemType := docItemType(docType)
+		item := g.hydrateExpression(itemType, itemType, "$item", false)
+		if itemType == "" || item == "$item" {
+			cast = fmt.Sprintf("(array) %s", variable)
+		} else {
+			cast = fmt.Sprintf("array_map(static fn ($item) => %s, (array) %s)", item, variable)
+		}
+	default:
+		if !isPHPClassName(typeName) {
+			return variable
+		}
+		instanceCheck := fmt.Sprintf("%s instanceof %s", variable, typeName)
+		if nullable {
+			instanceCheck += fmt.Sprintf(" || %s === null", variable)
+		}
+		if g.enumBackingType(typeName) != "" {
+			return fmt.Sprintf("%s ? %s : %s::from(%s)", instanceCheck, variable, typeName, variable)
+		}
+		return fmt.Sprintf("%s ? %s : %s::fromArray(%s)", instanceCheck, variable, typeName, variable)
+	}
+
+	if nullable {
+		return fmt.Sprintf("%s === null ? null : %s", variable, cast)
+	}
+	return cast
+}
+
+// serializeExpression returns the PHP expression converting the non-null
+// value in variable into its payload representation.
+func (g *Generator) serializeExpression(phpType, docType, variable string) string {
+	typeName := strings.TrimPrefix(phpType, "?")
+	switch typeName {
+	case "", "mixed", "string", "int", "float", "bool":
+		return variable
+	case "array":
+		itemType := docItemType(docType)
+		item := g.serializeExpression(itemType, itemType, "$item")
+		if itemType == "" || item == "$item" {
+			return variable
+		}
+		return fmt.Sprintf("array_map(static fn (%s $item) => %s, %s)", itemType, item, variable)
+	}
+
+	if !isPHPClassName(typeName) {
+		return variable
+	}
+	if g.enumBackingType(typeName) != "" {
+		return variable + "->value"
+	}
+	return variable + "->toArray()"
+}
+
+// docItemType returns the item type of an array docblock type such as
+// `Foo[]|null` or `array<string, Foo>`.
+func docItemType(docType string) string {
+	for _, part := range strings.Split(docType, "|") {
+		part = strings.TrimSpace(part)
+		if strings.HasSuffix(part, "[]") {
+			return strings.TrimSuffix(part, "[]")
+		}
+		if strings.HasPrefix(part, "array<") && strings.HasSuffix(part, ">") {
+			parameters := strings.SplitN(strings.TrimSuffix(strings.TrimPrefix(part, "array<"), ">"), ",", 2)
+			if len(parameters) == 2 {
+				return strings.TrimSpace(parameters[1])
+			}
+		}
+	}
+	return ""
+}
+
+// isPHPClassName reports whether typeName names a single class, as opposed to
+// a builtin, a union or a nested generic type.
+func isPHPClassName(typeName string) bool {
+	if typeName == "" || isBuiltinPHPType(typeName) {
+		return false
+	}
+	return !strings.ContainsAny(typeName, "|[]<>, ")
+}
+
+// constructorBaseClass returns the nearest base class rendering a
+// constructor, whose required arguments fromArray() must then pass, if any.
+func (g *Generator) constructorBaseClass(schema *base.SchemaProxy) *base.SchemaProxy {
+	for parent := schemaBaseClass(schema); parent != nil; parent = schemaBaseClass(parent) {
+		if g.shouldGenerateConstructorForClass(g.classNameForSchema(parent)) {
+			return parent
+		}
+	}
+	return nil
+}
+
+// inheritedRequiredProperties returns the required arguments of the
+// constructor of a base class, in the order of its properties. The
+// properties of a class carry the flags of the base class they inherit.
+func inheritedRequiredProperties(properties []phpProperty, inherited []schemaPropertyEntry) []phpProperty {
+	bySerializedName := make(map[string]phpProperty, len(properties))
+	for _, prop := range properties {
+		bySerializedName[prop.SerializedName] = prop
+	}
+
+	var result []phpProperty
+	for _, entry := range inherited {
+		if prop, ok := bySerializedName[entry.Name]; ok && !prop.Optional {
+			result = append(result, prop)
+		}
+	}
+	return result
+}
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"maps"
 	"regexp"
 	"strings"
 
@@ -9,24 +10,17 @@ import (
 
 	"github.com/iancoleman/strcase"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
 )
 
 var pathParamRegexp = regexp.MustCompile(`\{([^}]+)\}`)
 
-func (g *Generator) buildServiceBlock(tagKey string, operations []*operation) string {
+func (g *Generator) buildServiceNamespace(tagKey string, operations []*operation) *php.Namespace {
 	className := g.displayTagName(tagKey)
-	normalizeInlineResponseClassNames(className, operations)
+	g.normalizeInlineResponseClassNames(className, operations)
 
-	var buf strings.Builder
-	buf.WriteString("namespace SumUp\\Services;\n\n")
-	buf.WriteString("use SumUp\\HttpClient\\HttpClientInterface;\n")
-	buf.WriteString("use SumUp\\HttpClient\\RequestHeaders;\n")
-	buf.WriteString("use SumUp\\HttpClient\\RequestOptions;\n")
-	if serviceHasRequestBody(operations) {
-		buf.WriteString("use SumUp\\RequestEncoder;\n")
-	}
-	buf.WriteString("use SumUp\\ResponseDecoder;\n")
-	buf.WriteString("\n")
+	namespace := &php.Namespace{Name: g.servicesNamespace()}
 
 	inlineResponseSchemas := collectInlineResponseSchemas(operations)
 	serviceInlineSchemas := make(map[string]*base.SchemaProxy)
@@ -35,13 +29,14 @@ func (g *Generator) buildServiceBlock(tagKey string, operations []*operation) st
 		g.collectNestedInlineServiceSchemas(name, schema, serviceInlineSchemas, make(map[*base.SchemaProxy]struct{}))
 	}
 
+	requestInlineSchemas := make(map[string]*base.SchemaProxy)
 	seenRequestBodies := make(map[string]struct{})
 	for _, op := range operations {
 		if !shouldGenerateRequestBodyClass(op) {
 			continue
 		}
 
-		requestClass := requestBodyClassName(className, op)
+		requestClass := g.requestBodyClassName(className, op)
 		if _, ok := seenRequestBodies[requestClass]; ok {
 			op.BodyType = requestClass
 			op.BodyDocType = requestClass
@@ -52,15 +47,23 @@ func (g *Generator) buildServiceBlock(tagKey string, operations []*operation) st
 		op.BodyDocType = requestClass
 
 		if op.BodySchema != nil {
-			g.collectNestedInlineServiceSchemas(requestClass, op.BodySchema, serviceInlineSchemas, make(map[*base.SchemaProxy]struct{}))
+			g.collectNestedInlineServiceSchemas(requestClass, op.BodySchema, requestInlineSchemas, make(map[*base.SchemaProxy]struct{}))
 		}
 
 		if op.BodySchema != nil {
-			buf.WriteString(g.buildPHPClass(requestClass, op.BodySchema, "SumUp\\Services"))
+			namespace.Decls = append(namespace.Decls, g.buildPHPClass(requestClass, op.BodySchema, g.servicesNamespace()))
 		} else {
-			buf.WriteString(buildEmptyRequestBodyClass(requestClass))
+			namespace.Decls = append(namespace.Decls, g.buildEmptyRequestBodyClass(requestClass))
+		}
+	}
+
+	if g.cfg.ReadonlyResponses {
+		g.markReadonlyInlineSchemas(serviceInlineSchemas, requestInlineSchemas)
+	}
+	for name, schema := range requestInlineSchemas {
+		if _, ok := serviceInlineSchemas[name]; !ok {
+			serviceInlineSchemas[name] = schema
 		}
-		buf.WriteString("\n")
 	}
 
 	if len(serviceInlineSchemas) > 0 {
@@ -70,8 +73,7 @@ func (g *Generator) buildServiceBlock(tagKey string, operations []*operation) st
 		}
 		slices.Sort(inlineNames)
 		for _, name := range inlineNames {
-			buf.WriteString(g.buildPHPClass(name, serviceInlineSchemas[name], "SumUp\\Services"))
-			buf.WriteString("\n")
+			namespace.Decls = append(namespace.Decls, g.buildPHPClass(name, serviceInlineSchemas[name], g.servicesNamespace()))
 		}
 	}
 
@@ -80,70 +82,111 @@ func (g *Generator) buildServiceBlock(tagKey string, operations []*operation) st
 		if op == nil || !op.HasQuery {
 			continue
 		}
-		paramsClass := queryParamsClassName(className, op)
+		paramsClass := g.queryParamsClassName(className, op)
 		if _, ok := seenParams[paramsClass]; ok {
 			continue
 		}
 		seenParams[paramsClass] = struct{}{}
-		buf.WriteString(buildQueryParamsClass(paramsClass, op.QueryParams))
-		buf.WriteString("\n")
+		namespace.Decls = append(namespace.Decls, g.buildQueryParamsClass(paramsClass, op.QueryParams))
+	}
+
+	service := &php.Class{
+		Doc:        php.DocBlock{"Class " + className, "", g.tagDescription(tagKey), "", "@package " + g.servicesNamespace()},
+		Name:       className,
+		Implements: []string{g.serviceName("SumUpService")},
+		Properties: []php.Property{
+			{
+				Doc: php.DocBlock{
+					"The client for the http communication.",
+					"",
+					"@var " + g.runtimeName("HttpClient\\HttpClientInterface"),
+				},
+				Visibility: "protected",
+				Type:       g.runtimeName("HttpClient\\HttpClientInterface"),
+				Name:       "client",
+			},
+			{
+				Doc: php.DocBlock{
+					"The access token needed for authentication for the services.",
+					"",
+					"@var string",
+				},
+				Visibility: "protected",
+				Type:       "string",
+				Name:       "accessToken",
+			},
+		},
+		Methods: []php.Method{{
+			Doc: php.DocBlock{
+				className + " constructor.",
+				"",
+				"@param " + g.runtimeName("HttpClient\\HttpClientInterface") + " $client",
+				"@param string $accessToken",
+			},
+			Name: "__construct",
+			Params: []php.Param{
+				{Type: g.runtimeName("HttpClient\\HttpClientInterface"), Name: "client"},
+				{Type: "string", Name: "accessToken"},
+			},
+			Body: "$this->client = $client;\n$this->accessToken = $accessToken;",
+		}},
+	}
+	serviceOperations := make(map[string]*operation, len(operations))
+	for _, op := range operations {
+		method := g.buildServiceMethod(className, op)
+		serviceOperations[method.Name] = op
+		service.Methods = append(service.Methods, method)
 	}
+	g.classOperations[service] = serviceOperations
+	namespace.Decls = append(namespace.Decls, service)
 
-	fmt.Fprintf(&buf, "/**\n * Class %s\n", className)
-	if description := g.tagDescription(tagKey); description != "" {
-		buf.WriteString(" *\n")
-		for _, line := range strings.Split(description, "\n") {
-			line = strings.TrimSpace(line)
-			if line == "" {
-				buf.WriteString(" *\n")
-				continue
+	return namespace
+}
+
+// claimServiceNames claims the names of the services in tag order, before
+// the names of the other classes of the services namespace and, with
+// ModelPlacementTag, before the names of the models.
+func (g *Generator) claimServiceNames() {
+	tagKeys := slices.Collect(maps.Keys(g.tagLookup))
+	if g.spec.Paths != nil {
+		for _, pathItem := range g.spec.Paths.PathItems.FromOldest() {
+			for _, op := range pathItem.GetOperations().FromOldest() {
+				for _, tag := range op.Tags {
+					tagKeys = append(tagKeys, normalizeTagKey(tag))
+				}
 			}
-			buf.WriteString(" * ")
-			buf.WriteString(line)
-			buf.WriteString("\n")
-		}
-	}
-	buf.WriteString(" *\n * @package SumUp\\Services\n */\n")
-	fmt.Fprintf(&buf, "class %s implements SumUpService\n{\n", className)
-	buf.WriteString("    /**\n")
-	buf.WriteString("     * The client for the http communication.\n")
-	buf.WriteString("     *\n")
-	buf.WriteString("     * @var HttpClientInterface\n")
-	buf.WriteString("     */\n")
-	buf.WriteString("    protected HttpClientInterface $client;\n\n")
-	buf.WriteString("    /**\n")
-	buf.WriteString("     * The access token needed for authentication for the services.\n")
-	buf.WriteString("     *\n")
-	buf.WriteString("     * @var string\n")
-	buf.WriteString("     */\n")
-	buf.WriteString("    protected string $accessToken;\n\n")
-	buf.WriteString("    /**\n")
-	buf.WriteString("     * ")
-	buf.WriteString(className)
-	buf.WriteString(" constructor.\n")
-	buf.WriteString("     *\n")
-	buf.WriteString("     * @param HttpClientInterface $client\n")
-	buf.WriteString("     * @param string $accessToken\n")
-	buf.WriteString("     */\n")
-	buf.WriteString("    public function __construct(HttpClientInterface $client, string $accessToken)\n")
-	buf.WriteString("    {\n")
-	buf.WriteString("        $this->client = $client;\n")
-	buf.WriteString("        $this->accessToken = $accessToken;\n")
-	buf.WriteString("    }\n\n")
-
-	for idx, op := range operations {
-		buf.WriteString(g.renderServiceMethod(className, op))
-		if idx < len(operations)-1 {
-			buf.WriteString("\n")
-		}
-	}
-
-	buf.WriteString("}\n")
+		}
+	}
+	slices.Sort(tagKeys)
+	for _, tagKey := range slices.Compact(tagKeys) {
+		name := g.displayTagName(tagKey)
+		// The tag file declares the service next to the models of the tag,
+		// which must not take its name.
+		if g.placement == ModelPlacementTag {
+			g.modelNames.claim(tagOwner(tagKey), name)
+		}
+	}
+}
 
-	return buf.String()
+// claimOperationClassNames claims the names of the request body and query
+// parameters classes in tag and operation order, so that they do not depend
+// on which classes are rendered first.
+func (g *Generator) claimOperationClassNames() {
+	tagKeys := slices.Sorted(maps.Keys(g.operationsByTag))
+	for _, tagKey := range tagKeys {
+		serviceClass := g.displayTagName(tagKey)
+		for _, op := range g.operationsByTag[tagKey] {
+			if shouldGenerateRequestBodyClass(op) {
+				g.requestBodyClassName(serviceClass, op)
+			}
+			if op.HasQuery {
+				g.queryParamsClassName(serviceClass, op)
+			}
+		}
+	}
 }
 
-func normalizeInlineResponseClassNames(serviceClass string, operations []*operation) {
+func (g *Generator) normalizeInlineResponseClassNames(serviceClass string, operations []*operation) {
 	for _, op := range operations {
 		if op == nil {
 			continue
@@ -165,35 +208,34 @@ func normalizeInlineResponseClassNames(serviceClass string, operations []*operat
 				inlineName = fmt.Sprintf("%s%s", baseName, resp.StatusCode)
 			}
 
-			renameInlineResponseType(resp.Type, inlineName)
+			owner := operationClassOwner{service: serviceClass, method: op.Method, path: op.Path, role: "response " + resp.StatusCode}
+			g.renameInlineResponseType(resp.Type, owner, inlineName)
 		}
 	}
 }
 
-func renameInlineResponseType(rt *responseType, inlineName string) {
+func (g *Generator) renameInlineResponseType(rt *responseType, owner operationClassOwner, inlineName string) {
 	if rt == nil {
 		return
 	}
 
 	if rt.InlineClassName != "" && rt.InlineSchema != nil {
-		rt.InlineClassName = inlineName
-		rt.ClassName = "\\SumUp\\Services\\" + inlineName
+		rt.InlineClassName = g.claimServiceClassName(owner, rt.InlineSchema, inlineName)
+		rt.ClassName = g.serviceName(rt.InlineClassName)
 	}
 
 	if rt.ArrayItems != nil {
-		renameInlineResponseType(rt.ArrayItems, inlineName+"Item")
+		owner.role += " []"
+		g.renameInlineResponseType(rt.ArrayItems, owner, inlineName+"Item")
 	}
 }
 
-func (g *Generator) renderServiceMethod(serviceClass string, op *operation) string {
-	var buf strings.Builder
-
+func (g *Generator) buildServiceMethod(serviceClass string, op *operation) php.Method {
 	methodName := op.methodName()
 	if methodName == "" {
 		methodName = "call"
 	}
 
-	buf.WriteString("    /**\n")
 	summary := op.Summary
 	if summary == "" {
 		summary = op.Description
@@ -201,155 +243,117 @@ func (g *Generator) renderServiceMethod(serviceClass string, op *operation) stri
 	if summary == "" {
 		summary = fmt.Sprintf("Call %s %s.", op.Method, op.Path)
 	}
-	buf.WriteString("     * ")
-	buf.WriteString(summary)
-	buf.WriteString("\n     *\n")
+	doc := php.DocBlock{summary, ""}
 
 	for _, param := range op.PathParams {
-		buf.WriteString("     * @param string $")
-		buf.WriteString(param.VarName)
-		if param.Description != "" {
-			buf.WriteString(" ")
-			buf.WriteString(param.Description)
-		}
-		buf.WriteString("\n")
+		doc = append(doc, strings.TrimSpace(fmt.Sprintf("@param string $%s %s", param.VarName, param.Description)))
 	}
 
 	if op.HasQuery {
-		fmt.Fprintf(&buf, "     * @param %s|null $queryParams Optional query string parameters\n", queryParamsClassName(serviceClass, op))
+		doc = append(doc, fmt.Sprintf("@param %s|null $queryParams Optional query string parameters", g.queryParamsClassName(serviceClass, op)))
 	}
 
 	if op.HasBody {
-		fmt.Fprintf(&buf, "     * @param %s $body %s request payload\n", renderBodyDocType(op), renderBodyDocQualifier(op))
+		doc = append(doc, fmt.Sprintf("@param %s $body %s request payload", renderBodyDocType(op), renderBodyDocQualifier(op)))
 	}
-	buf.WriteString("     * @param RequestOptions|null $requestOptions Optional typed request options\n")
-
-	buf.WriteString("     *\n")
-	fmt.Fprintf(&buf, "     * @return %s\n", renderOperationReturnDoc(op))
-	buf.WriteString("     * @throws \\SumUp\\Exception\\ApiException\n")
-	buf.WriteString("     * @throws \\SumUp\\Exception\\UnexpectedApiException\n")
-	buf.WriteString("     * @throws \\SumUp\\Exception\\ConnectionException\n")
-	buf.WriteString("     * @throws \\SumUp\\Exception\\SDKException\n")
-
-	if op.Deprecated {
-		buf.WriteString("     *\n")
-		buf.WriteString("     * @deprecated\n")
+	doc = append(doc,
+		fmt.Sprintf("@param %s|null $requestOptions Optional typed request options", g.runtimeName("HttpClient\\RequestOptions")),
+		"",
+		fmt.Sprintf("@return %s", g.renderOperationReturnDoc(op)),
+	)
+	for _, exception := range []string{"ApiException", "UnexpectedApiException", "ConnectionException", "SDKException"} {
+		doc = append(doc, "@throws "+g.runtimeName("Exception\\"+exception))
 	}
 
-	buf.WriteString("     */\n")
+	if status := op.doc(); len(status) > 0 {
+		doc = append(doc, "")
+		doc = append(doc, status...)
+	}
 
-	args := make([]string, 0, len(op.PathParams)+2)
+	params := make([]php.Param, 0, len(op.PathParams)+3)
 	for _, param := range op.PathParams {
-		args = append(args, "string $"+param.VarName)
+		params = append(params, php.Param{Type: "string", Name: param.VarName})
 	}
 	if op.HasQuery {
-		args = append(args, fmt.Sprintf("?%s $queryParams = null", queryParamsClassName(serviceClass, op)))
+		params = append(params, php.Param{Type: "?" + g.queryParamsClassName(serviceClass, op), Name: "queryParams", Default: "null"})
 	}
 	if op.HasBody {
-		args = append(args, renderBodyArgument(op))
+		params = append(params, renderBodyArgument(g.php, op))
 	}
-	args = append(args, "?RequestOptions $requestOptions = null")
+	params = append(params, php.Param{Type: "?" + g.runtimeName("HttpClient\\RequestOptions"), Name: "requestOptions", Default: "null"})
 
-	buf.WriteString("    public function ")
-	buf.WriteString(methodName)
-	buf.WriteString("(")
-	buf.WriteString(strings.Join(args, ", "))
-	buf.WriteString(")")
-	if returnType := renderOperationReturnTypeHint(op); returnType != "" {
-		buf.WriteString(": ")
-		buf.WriteString(returnType)
+	var body strings.Builder
+	if trigger := g.deprecationTrigger(methodName+"()", op.lifecycle); trigger != "" {
+		body.WriteString(trigger)
+		body.WriteString("\n")
 	}
-	buf.WriteString("\n")
-	buf.WriteString("    {\n")
-
-	buf.WriteString(renderPathAssignment(op))
+	body.WriteString(renderPathAssignment(op))
 
 	if op.HasQuery {
-		buf.WriteString("        if ($queryParams !== null) {\n")
-		buf.WriteString("            $queryParamsData = [];\n")
+		body.WriteString("if ($queryParams !== null) {\n")
+		body.WriteString("    $queryParamsData = [];\n")
 		for _, qp := range op.QueryParams {
 			if qp.VarName == "" || qp.OriginalName == "" {
 				continue
 			}
-			fmt.Fprintf(&buf, "            if (isset($queryParams->%s)) {\n", qp.VarName)
-			fmt.Fprintf(&buf, "                $queryParamsData['%s'] = $queryParams->%s;\n", qp.OriginalName, qp.VarName)
-			buf.WriteString("            }\n")
+			// A parameter left to its default is not sent, so that the server
+			// applies its own default.
+			if qp.Default != "" {
+				fmt.Fprintf(&body, "    if (isset($queryParams->%s) && %s) {\n", qp.VarName, differsFromDefault("$queryParams->"+qp.VarName, qp.Type, qp.Default))
+			} else {
+				fmt.Fprintf(&body, "    if (isset($queryParams->%s)) {\n", qp.VarName)
+			}
+			fmt.Fprintf(&body, "        $queryParamsData[%s] = $queryParams->%s;\n", phpString(qp.OriginalName), qp.VarName)
+			body.WriteString("    }\n")
 		}
-		buf.WriteString("            if (!empty($queryParamsData)) {\n")
-		buf.WriteString("                $queryString = http_build_query($queryParamsData);\n")
-		buf.WriteString("                if (!empty($queryString)) {\n")
-		buf.WriteString("                    $path .= '?' . $queryString;\n")
-		buf.WriteString("                }\n")
-		buf.WriteString("            }\n")
-		buf.WriteString("        }\n")
+		body.WriteString("    if (!empty($queryParamsData)) {\n")
+		body.WriteString("        $queryString = http_build_query($queryParamsData);\n")
+		body.WriteString("        if (!empty($queryString)) {\n")
+		body.WriteString("            $path .= '?' . $queryString;\n")
+		body.WriteString("        }\n")
+		body.WriteString("    }\n")
+		body.WriteString("}\n")
 	}
 
-	buf.WriteString("        $payload = [];\n")
+	body.WriteString("$payload = [];\n")
 	if op.HasBody {
 		if op.BodyRequired {
-			buf.WriteString(g.renderBodyEncoding("$body", op.BodyType, "        "))
+			body.WriteString(g.renderBodyEncoding("$body", op.BodyType, ""))
 		} else {
-			buf.WriteString("        if ($body !== null) {\n")
-			buf.WriteString(g.renderBodyEncoding("$body", op.BodyType, "            "))
-			buf.WriteString("        }\n")
+			body.WriteString("if ($body !== null) {\n")
+			body.WriteString(g.renderBodyEncoding("$body", op.BodyType, "    "))
+			body.WriteString("}\n")
 		}
 	}
 
-	buf.WriteString("        $headers = RequestHeaders::build($this->accessToken, $requestOptions);\n\n")
-	fmt.Fprintf(&buf, "        $response = $this->client->send('%s', $path, $payload, $headers, $requestOptions);\n\n", strings.ToUpper(op.Method))
+	httpMethod := phpString(strings.ToUpper(op.Method))
+	fmt.Fprintf(&body, "$headers = %s::build($this->accessToken, $requestOptions);\n\n", g.runtimeName("HttpClient\\RequestHeaders"))
+	fmt.Fprintf(&body, "$response = $this->client->send(%s, $path, $payload, $headers, $requestOptions);\n\n", httpMethod)
+
 	successDescriptor := renderOperationSuccessResponseDescriptor(op)
+	if successDescriptor == "" {
+		successDescriptor = "null"
+	}
 	errorDescriptor := renderOperationErrorResponseDescriptor(op)
-
-	switch {
-	case successDescriptor != "" && errorDescriptor != "":
-		fmt.Fprintf(
-			&buf,
-			"        return ResponseDecoder::decodeOrThrow($response, %s, %s, '%s', $path);\n",
-			successDescriptor,
-			errorDescriptor,
-			strings.ToUpper(op.Method),
-		)
-	case successDescriptor != "":
-		fmt.Fprintf(
-			&buf,
-			"        return ResponseDecoder::decodeOrThrow($response, %s, null, '%s', $path);\n",
-			successDescriptor,
-			strings.ToUpper(op.Method),
-		)
-	case errorDescriptor != "":
-		fmt.Fprintf(
-			&buf,
-			"        return ResponseDecoder::decodeOrThrow($response, null, %s, '%s', $path);\n",
-			errorDescriptor,
-			strings.ToUpper(op.Method),
-		)
-	default:
-		fmt.Fprintf(
-			&buf,
-			"        return ResponseDecoder::decodeOrThrow($response, null, null, '%s', $path);\n",
-			strings.ToUpper(op.Method),
-		)
+	if errorDescriptor == "" {
+		errorDescriptor = "null"
 	}
-	buf.WriteString("    }\n")
-
-	return buf.String()
-}
+	fmt.Fprintf(&body, "return %s::decodeOrThrow($response, %s, %s, %s, $path);\n", g.runtimeName("ResponseDecoder"), successDescriptor, errorDescriptor, httpMethod)
 
-func queryParamsClassName(serviceClass string, op *operation) string {
-	methodName := op.methodName()
-	if methodName == "" {
-		methodName = "Operation"
+	return php.Method{
+		Doc:        doc,
+		Name:       methodName,
+		Params:     params,
+		ReturnType: g.php.typeHint(renderOperationReturnTypeHint(op)),
+		Body:       body.String(),
 	}
-	if serviceClass != "" {
-		return fmt.Sprintf("%s%sParams", serviceClass, strcase.ToCamel(methodName))
-	}
-	return fmt.Sprintf("%sParams", strcase.ToCamel(methodName))
 }
 
-func buildQueryParamsClass(className string, params []operationParam) string {
-	var buf strings.Builder
-	fmt.Fprintf(&buf, "/**\n * Query parameters for %s.\n *\n * @package SumUp\\Services\n */\n", className)
-	fmt.Fprintf(&buf, "class %s\n{\n", className)
+func (g *Generator) buildQueryParamsClass(className string, params []operationParam) *php.Class {
+	class := &php.Class{
+		Doc:  php.DocBlock{fmt.Sprintf("Query parameters for %s.", className), "", "@package " + g.servicesNamespace()},
+		Name: className,
+	}
 
 	for _, param := range params {
 		prop := phpProperty{
@@ -358,55 +362,25 @@ func buildQueryParamsClass(className string, params []operationParam) string {
 			DocType:     param.DocType,
 			Optional:    !param.Required,
 			Description: param.Description,
+			Default:     param.Default,
 		}
-		buf.WriteString(renderQueryParamProperty(prop))
+		class.Properties = append(class.Properties, g.buildProperty(prop, false))
 	}
 
-	buf.WriteString("}\n")
-	return buf.String()
+	return class
 }
 
-func renderQueryParamProperty(prop phpProperty) string {
-	var b strings.Builder
-
-	b.WriteString("    /**\n")
-	if prop.Description != "" {
-		for _, line := range strings.Split(prop.Description, "\n") {
-			line = strings.TrimSpace(line)
-			if line == "" {
-				continue
-			}
-			b.WriteString("     * ")
-			b.WriteString(line)
-			b.WriteString("\n")
-		}
-	}
-	b.WriteString("     *\n")
-	docType := prop.DocType
-	if prop.Optional {
-		if !strings.Contains(docType, "null") {
-			docType += "|null"
-		}
-	}
-	fmt.Fprintf(&b, "     * @var %s\n", docType)
-	b.WriteString("     */\n")
-
-	propertyType := prop.Type
-	if prop.Optional && propertyType != "mixed" && !strings.HasPrefix(propertyType, "?") {
-		propertyType = "?" + propertyType
-	}
-
-	if propertyType == "" {
-		propertyType = "mixed"
+func (g *Generator) queryParamsClassName(serviceClass string, op *operation) string {
+	methodName := op.methodName()
+	if methodName == "" {
+		methodName = "Operation"
 	}
 
-	if prop.Optional {
-		fmt.Fprintf(&b, "    public %s $%s = null;\n\n", propertyType, prop.Name)
-	} else {
-		fmt.Fprintf(&b, "    public %s $%s;\n\n", propertyType, prop.Name)
+	name := fmt.Sprintf("%sParams", strcase.ToCamel(methodName))
+	if serviceClass != "" {
+		name = fmt.Sprintf("%s%sParams", serviceClass, strcase.ToCamel(methodName))
 	}
-
-	return b.String()
+	return g.serviceNames.claim(operationClassOwner{service: serviceClass, method: op.Method, path: op.Path, role: "params"}, name)
 }
 
 func collectInlineResponseSchemas(operations []*operation) map[string]*base.SchemaProxy {
@@ -425,16 +399,6 @@ func collectInlineResponseSchemas(operations []*operation) map[string]*base.Sche
 	return result
 }
 
-func serviceHasRequestBody(operations []*operation) bool {
-	for _, op := range operations {
-		if op != nil && op.HasBody {
-			return true
-		}
-	}
-
-	return false
-}
-
 func shouldGenerateRequestBodyClass(op *operation) bool {
 	if op == nil || !op.HasBody {
 		return false
@@ -455,34 +419,46 @@ func shouldGenerateRequestBodyClass(op *operation) bool {
 	return schemaShouldGenerateClass(op.BodySchema)
 }
 
-func buildEmptyRequestBodyClass(className string) string {
-	var buf strings.Builder
-	fmt.Fprintf(&buf, "/**\n * Request payload for %s.\n *\n * @package SumUp\\Services\n */\n", className)
-	fmt.Fprintf(&buf, "class %s\n{\n", className)
-	buf.WriteString("    /**\n")
-	buf.WriteString("     * Create request DTO from an associative array.\n")
-	buf.WriteString("     *\n")
-	buf.WriteString("     * @param array<string, mixed> $data\n")
-	buf.WriteString("     */\n")
-	buf.WriteString("    public static function fromArray(array $data): self\n")
-	buf.WriteString("    {\n")
-	buf.WriteString("        return new self();\n")
-	buf.WriteString("    }\n")
-	buf.WriteString("}\n")
-	return buf.String()
+func (g *Generator) buildEmptyRequestBodyClass(className string) *php.Class {
+	return &php.Class{
+		Doc:  php.DocBlock{fmt.Sprintf("Request payload for %s.", className), "", "@package " + g.servicesNamespace()},
+		Name: className,
+		Methods: []php.Method{{
+			Doc: php.DocBlock{
+				"Create request DTO from an associative array.",
+				"",
+				"@param array<string, mixed> $data",
+			},
+			Static:     true,
+			Name:       "fromArray",
+			Params:     []php.Param{{Type: "array", Name: "data"}},
+			ReturnType: "self",
+			Body:       "return new self();",
+		}},
+	}
 }
 
-func requestBodyClassName(serviceClass string, op *operation) string {
+func (g *Generator) requestBodyClassName(serviceClass string, op *operation) string {
 	methodName := op.methodName()
 	if methodName == "" {
 		methodName = "Operation"
 	}
 
+	name := fmt.Sprintf("%sRequest", strcase.ToCamel(methodName))
 	if serviceClass != "" {
-		return fmt.Sprintf("%s%sRequest", serviceClass, strcase.ToCamel(methodName))
+		name = fmt.Sprintf("%s%sRequest", serviceClass, strcase.ToCamel(methodName))
 	}
+	return g.claimServiceClassName(operationClassOwner{service: serviceClass, method: op.Method, path: op.Path, role: "request"}, op.BodySchema, name)
+}
 
-	return fmt.Sprintf("%sRequest", strcase.ToCamel(methodName))
+// claimServiceClassName returns the unique name of a class of the services
+// namespace, derived from the x-codegen.class_name extension of its schema,
+// if any, or from name.
+func (g *Generator) claimServiceClassName(owner any, schema *base.SchemaProxy, name string) string {
+	if override := schemaClassNameOverride(schema); override != "" {
+		name = override
+	}
+	return g.serviceNames.claim(owner, name)
 }
 
 func collectInlineResponseSchema(rt *responseType, acc map[string]*base.SchemaProxy) {
@@ -519,6 +495,7 @@ func (g *Generator) collectNestedInlineServiceSchemas(parentName string, schema
 		for propName, propSchema := range spec.Properties.FromOldest() {
 			name := g.inlinePropertyClassName(parentName, propName, propSchema)
 			if name != "" {
+				name = g.claimServiceClassName(inlineClassOwner{parent: parentName, position: propName}, propSchema, name)
 				if _, ok := acc[name]; !ok {
 					acc[name] = propSchema
 				}
@@ -533,6 +510,7 @@ func (g *Generator) collectNestedInlineServiceSchemas(parentName string, schema
 	if hasSchemaType(spec, "array") && spec.Items != nil && spec.Items.A != nil {
 		itemName := g.inlineArrayItemClassName(parentName, spec.Items.A)
 		if itemName != "" {
+			itemName = g.claimServiceClassName(inlineClassOwner{parent: parentName, position: "[]"}, spec.Items.A, itemName)
 			if _, ok := acc[itemName]; !ok {
 				acc[itemName] = spec.Items.A
 			}
@@ -568,7 +546,7 @@ func renderResponseTypeDescriptor(rt *responseType) string {
 		}
 		return "['type' => 'array']"
 	case responseTypeScalar:
-		return fmt.Sprintf("['type' => 'scalar', 'scalar' => '%s']", rt.ScalarType)
+		return fmt.Sprintf("['type' => 'scalar', 'scalar' => %s]", phpString(rt.ScalarType))
 	case responseTypeObject:
 		return "['type' => 'object']"
 	case responseTypeVoid:
@@ -592,9 +570,9 @@ func formatClassReference(name string) string {
 	return name
 }
 
-func renderOperationReturnDoc(op *operation) string {
+func (g *Generator) renderOperationReturnDoc(op *operation) string {
 	if op == nil || len(op.Responses) == 0 {
-		return "\\SumUp\\HttpClient\\Response"
+		return g.runtimeName("HttpClient\\Response")
 	}
 
 	docTypes := make([]string, 0, len(op.Responses))
@@ -616,7 +594,7 @@ func renderOperationReturnDoc(op *operation) string {
 	}
 
 	if len(docTypes) == 0 {
-		return "\\SumUp\\HttpClient\\Response"
+		return g.runtimeName("HttpClient\\Response")
 	}
 
 	return strings.Join(docTypes, "|")
@@ -748,9 +726,9 @@ func renderOperationSuccessResponseDescriptor(op *operation) string {
 		if resp == nil || resp.Type == nil {
 			continue
 		}
-		fmt.Fprintf(&buf, "            '%s' => %s,\n", resp.StatusCode, renderResponseTypeDescriptor(resp.Type))
+		fmt.Fprintf(&buf, "    %s => %s,\n", phpString(resp.StatusCode), renderResponseTypeDescriptor(resp.Type))
 	}
-	buf.WriteString("        ]")
+	buf.WriteString("]")
 
 	return buf.String()
 }
@@ -777,16 +755,16 @@ func renderOperationErrorResponseDescriptor(op *operation) string {
 		if resp == nil || resp.Type == nil {
 			continue
 		}
-		fmt.Fprintf(&buf, "            '%s' => %s,\n", resp.StatusCode, renderResponseTypeDescriptor(resp.Type))
+		fmt.Fprintf(&buf, "    %s => %s,\n", phpString(resp.StatusCode), renderResponseTypeDescriptor(resp.Type))
 	}
-	buf.WriteString("        ]")
+	buf.WriteString("]")
 
 	return buf.String()
 }
 
 func renderPathAssignment(op *operation) string {
 	if len(op.PathParams) == 0 {
-		return fmt.Sprintf("        $path = '%s';\n", op.Path)
+		return fmt.Sprintf("$path = %s;\n", phpString(op.Path))
 	}
 
 	format := op.Path
@@ -801,9 +779,8 @@ func renderPathAssignment(op *operation) string {
 	}
 
 	builder := strings.Builder{}
-	builder.WriteString("        $path = sprintf('")
-	builder.WriteString(format)
-	builder.WriteString("'")
+	builder.WriteString("$path = sprintf(")
+	builder.WriteString(phpString(format))
 	for _, originalName := range paramOrder {
 		varName := phpPropertyName(originalName)
 		builder.WriteString(", rawurlencode((string) $")
@@ -844,9 +821,9 @@ func renderBodyDocType(op *operation) string {
 	return baseType
 }
 
-func renderBodyArgument(op *operation) string {
+func renderBodyArgument(version phpVersion, op *operation) php.Param {
 	if op == nil {
-		return "?array $body = null"
+		return php.Param{Type: "?array", Name: "body", Default: "null"}
 	}
 
 	baseType := op.BodyType
@@ -860,15 +837,15 @@ func renderBodyArgument(op *operation) string {
 
 	if !op.BodyRequired {
 		if baseType == "array" {
-			return "?array $body = null"
+			return php.Param{Type: "?array", Name: "body", Default: "null"}
 		}
 		if !strings.Contains(baseType, "null") {
 			baseType += "|null"
 		}
-		return fmt.Sprintf("%s $body = null", baseType)
+		return php.Param{Type: version.typeHint(baseType), Name: "body", Default: "null"}
 	}
 
-	return fmt.Sprintf("%s $body", baseType)
+	return php.Param{Type: version.typeHint(baseType), Name: "body"}
 }
 
 func (g *Generator) renderBodyEncoding(bodyExpr string, bodyType string, indent string) string {
@@ -880,11 +857,11 @@ func (g *Generator) renderBodyEncoding(bodyExpr string, bodyType string, indent
 		fmt.Fprintf(&buf, "%sif (is_array($requestBody)) {\n", indent)
 		fmt.Fprintf(&buf, "%s    $requestBody = %s::fromArray($requestBody);\n", indent, classRef)
 		fmt.Fprintf(&buf, "%s}\n", indent)
-		fmt.Fprintf(&buf, "%s$payload = RequestEncoder::encode($requestBody);\n", indent)
+		fmt.Fprintf(&buf, "%s$payload = %s::encode($requestBody);\n", indent, g.runtimeName("RequestEncoder"))
 		return buf.String()
 	}
 
-	fmt.Fprintf(&buf, "%s$payload = RequestEncoder::encode(%s);\n", indent, bodyExpr)
+	fmt.Fprintf(&buf, "%s$payload = %s::encode(%s);\n", indent, g.runtimeName("RequestEncoder"), bodyExpr)
 	return buf.String()
 }
 
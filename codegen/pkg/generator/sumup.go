@@ -4,8 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"maps"
-	"os"
-	"path/filepath"
 	"slices"
 
 	"github.com/iancoleman/strcase"
@@ -18,20 +16,6 @@ var reservedServiceNames = map[string]struct{}{
 
 //nolint:unused // writeSumUpClass is kept for the legacy SumUp SDK surface
 func (g *Generator) writeSumUpClass() error {
-	dir := g.cfg.Out
-	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-		return fmt.Errorf("create SumUp directory: %w", err)
-	}
-
-	filename := filepath.Join(dir, "SumUp.php")
-	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
-	if err != nil {
-		return fmt.Errorf("open %q: %w", filename, err)
-	}
-	defer func() {
-		_ = f.Close()
-	}()
-
 	services := g.collectServiceDefinitions()
 
 	var buf bytes.Buffer
@@ -173,11 +157,7 @@ class SumUp
 }
 `)
 
-	if _, err := f.Write(buf.Bytes()); err != nil {
-		return fmt.Errorf("write SumUp file %q: %w", filename, err)
-	}
-
-	return nil
+	return g.writeFile("SumUp.php", buf.String())
 }
 
 //nolint:unused // helper is used when SumUp class generation is re-enabled
@@ -0,0 +1,258 @@
+package generator
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
+	"github.com/sumup/sumup-php/codegen/pkg/surface"
+)
+
+// Surface renders the SDK in memory and returns its public API, leaving the
+// output untouched.
+func (g *Generator) Surface() (surface.Surface, error) {
+	out := g.out
+	g.out = MemoryOutput{}
+	g.surface = &surfaceCollector{serviceInterface: g.servicesNamespace() + `\SumUpService`}
+	defer func() {
+		g.out = out
+		g.surface = nil
+	}()
+
+	if err := g.Build(); err != nil {
+		return surface.Surface{}, err
+	}
+
+	result := g.surface.surface
+	slices.SortFunc(result.Services, func(a, b surface.Class) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(result.Classes, func(a, b surface.Class) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(result.Interfaces, func(a, b surface.Interface) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(result.Enums, func(a, b surface.Enum) int { return cmp.Compare(a.Name, b.Name) })
+	return result, nil
+}
+
+// surfaceCollector gathers the public API of the PHP files written by Build.
+// The files are collected before their names are imported, when references
+// to other namespaces are still fully qualified.
+type surfaceCollector struct {
+	// serviceInterface is the interface implemented by the services.
+	serviceInterface string
+
+	surface surface.Surface
+}
+
+func (s *surfaceCollector) addFile(file *php.File) {
+	for _, namespace := range file.Namespaces {
+		for _, decl := range namespace.Decls {
+			s.addDecl(namespace.Name, decl)
+		}
+	}
+}
+
+func (s *surfaceCollector) addDecl(namespace string, decl php.Decl) {
+	switch d := decl.(type) {
+	case *php.Class:
+		class := surface.Class{
+			Name:       namespace + `\` + d.Name,
+			Extends:    qualifyType(namespace, d.Extends),
+			Final:      d.Final,
+			Readonly:   d.Readonly,
+			Deprecated: hasDocTag(d.Doc, "@deprecated"),
+		}
+		for _, name := range d.Implements {
+			class.Implements = append(class.Implements, qualifyType(namespace, name))
+		}
+		for _, constant := range d.Constants {
+			if isPublic(constant.Visibility) {
+				class.Constants = append(class.Constants, surface.Constant{Name: constant.Name, Value: string(constant.Value)})
+			}
+		}
+		for _, property := range d.Properties {
+			if isPublic(property.Visibility) {
+				class.Properties = append(class.Properties, surfaceProperty(namespace, property, d.Readonly))
+			}
+		}
+		for _, method := range d.Methods {
+			if isPublic(method.Visibility) {
+				class.Methods = append(class.Methods, surfaceMethod(namespace, method))
+			}
+		}
+
+		if slices.Contains(class.Implements, s.serviceInterface) {
+			s.surface.Services = append(s.surface.Services, class)
+		} else {
+			s.surface.Classes = append(s.surface.Classes, class)
+		}
+	case *php.Interface:
+		iface := surface.Interface{Name: namespace + `\` + d.Name}
+		for _, name := range d.Extends {
+			iface.Extends = append(iface.Extends, qualifyType(namespace, name))
+		}
+		s.surface.Interfaces = append(s.surface.Interfaces, iface)
+	case *php.Enum:
+		enum := surface.Enum{
+			Name:        namespace + `\` + d.Name,
+			BackingType: d.BackingType,
+		}
+		for _, enumCase := range d.Cases {
+			enum.Cases = append(enum.Cases, surface.Case{Name: enumCase.Name, Value: string(enumCase.Value)})
+		}
+		s.surface.Enums = append(s.surface.Enums, enum)
+	}
+}
+
+func surfaceProperty(namespace string, property php.Property, readonlyClass bool) surface.Property {
+	result := surface.Property{
+		Name:       property.Name,
+		Type:       qualifyType(namespace, property.Type),
+		Readonly:   property.Readonly || readonlyClass,
+		Deprecated: hasDocTag(property.Doc, "@deprecated"),
+	}
+	if docType := qualifyType(namespace, docTagType(property.Doc, "@var", "")); !sameType(docType, result.Type) {
+		result.DocType = docType
+	}
+	return result
+}
+
+func surfaceMethod(namespace string, method php.Method) surface.Method {
+	result := surface.Method{
+		Name:       method.Name,
+		Static:     method.Static,
+		ReturnType: qualifyType(namespace, method.ReturnType),
+		Deprecated: hasDocTag(method.Doc, "@deprecated"),
+	}
+	if docType := qualifyType(namespace, docTagType(method.Doc, "@return", "")); !sameType(docType, result.ReturnType) {
+		result.DocReturnType = docType
+	}
+	for _, param := range method.Params {
+		entry := surface.Param{
+			Name:     param.Name,
+			Type:     qualifyType(namespace, param.Type),
+			Optional: param.Default != "",
+		}
+		if docType := qualifyType(namespace, docTagType(method.Doc, "@param", "$"+param.Name)); !sameType(docType, entry.Type) {
+			entry.DocType = docType
+		}
+		result.Params = append(result.Params, entry)
+	}
+	return result
+}
+
+func isPublic(visibility string) bool {
+	return visibility == "" || visibility == "public"
+}
+
+// docLines returns the lines of a docblock, whose entries may span several
+// lines.
+func docLines(doc php.DocBlock) []string {
+	var lines []string
+	for _, entry := range doc {
+		for line := range strings.SplitSeq(entry, "\n") {
+			lines = append(lines, strings.TrimSpace(line))
+		}
+	}
+	return lines
+}
+
+func hasDocTag(doc php.DocBlock, tag string) bool {
+	for _, line := range docLines(doc) {
+		if line == tag || strings.HasPrefix(line, tag+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// docTagType returns the type of the first docblock tag of the given name,
+// such as `@var`, whose type is followed by variable when not empty.
+func docTagType(doc php.DocBlock, tag, variable string) string {
+	for _, line := range docLines(doc) {
+		rest, ok := strings.CutPrefix(line, tag+" ")
+		if !ok {
+			continue
+		}
+		typ, rest := splitDocType(strings.TrimSpace(rest))
+		if variable == "" || strings.HasPrefix(strings.TrimSpace(rest), variable) {
+			return typ
+		}
+	}
+	return ""
+}
+
+// splitDocType splits a docblock tag value after its type, which may contain
+// spaces within brackets, such as `array<string, mixed>`.
+func splitDocType(value string) (string, string) {
+	depth := 0
+	for idx, c := range value {
+		switch c {
+		case '<', '(', '{':
+			depth++
+		case '>', ')', '}':
+			depth--
+		case ' ':
+			if depth == 0 {
+				return value[:idx], value[idx:]
+			}
+		}
+	}
+	return value, ""
+}
+
+// phpBuiltinTypes are the keywords of native and docblock types, which are
+// not class names.
+var phpBuiltinTypes = map[string]struct{}{
+	"array": {}, "array-key": {}, "bool": {}, "callable": {}, "false": {}, "float": {},
+	"int": {}, "iterable": {}, "list": {}, "mixed": {}, "never": {}, "non-empty-array": {},
+	"non-empty-list": {}, "non-empty-string": {}, "null": {}, "object": {}, "parent": {},
+	"positive-int": {}, "resource": {}, "self": {}, "static": {}, "string": {}, "true": {},
+	"void": {},
+}
+
+// qualifyType resolves the class names of a native or docblock type against
+// the namespace, dropping the leading backslash of fully qualified names.
+func qualifyType(namespace, typ string) string {
+	var b strings.Builder
+	for idx := 0; idx < len(typ); {
+		if !isTypeNameByte(typ[idx]) {
+			b.WriteByte(typ[idx])
+			idx++
+			continue
+		}
+
+		end := idx
+		for end < len(typ) && isTypeNameByte(typ[end]) {
+			end++
+		}
+		name := typ[idx:end]
+		_, builtin := phpBuiltinTypes[strings.ToLower(name)]
+		switch {
+		case strings.HasPrefix(name, `\`):
+			b.WriteString(name[1:])
+		case builtin || name[0] >= '0' && name[0] <= '9':
+			b.WriteString(name)
+		default:
+			b.WriteString(namespace + `\` + name)
+		}
+		idx = end
+	}
+	return b.String()
+}
+
+func isTypeNameByte(c byte) bool {
+	return c == '\\' || c == '-' || c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+// sameType reports whether two types list the same members, such as
+// `?string` and `string|null`.
+func sameType(a, b string) bool {
+	members := func(typ string) []string {
+		if rest, ok := strings.CutPrefix(typ, "?"); ok {
+			typ = rest + "|null"
+		}
+		parts := strings.Split(typ, "|")
+		slices.Sort(parts)
+		return parts
+	}
+	return a == b || slices.Equal(members(a), members(b))
+}
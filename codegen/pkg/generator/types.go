@@ -1,13 +1,15 @@
 package generator
 
 import (
-	"bytes"
-	"fmt"
 	"log/slog"
-	"os"
+	"maps"
+	"path"
 	"path/filepath"
+	"slices"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+
+	"github.com/sumup/sumup-php/codegen/pkg/php"
 )
 
 func (g *Generator) writeTypeModels(schemas []*base.SchemaProxy) error {
@@ -16,61 +18,54 @@ func (g *Generator) writeTypeModels(schemas []*base.SchemaProxy) error {
 		return nil
 	}
 
-	dir := filepath.Join(g.cfg.Out, typesTagDisplayName)
-	if err := os.RemoveAll(dir); err != nil {
-		return fmt.Errorf("wipe types directory: %w", err)
-	}
-	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-		return fmt.Errorf("create types directory: %w", err)
-	}
-
 	enumCount := 0
 	for _, enum := range enums {
-		filename := filepath.Join(dir, fmt.Sprintf("%s.php", enum.Name))
-		f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
-		if err != nil {
-			return fmt.Errorf("open %q: %w", filename, err)
+		if err := g.writePHPFile(path.Join(g.layout.Types, enum.Name+".php"), g.typesFile(g.buildPHPEnum(enum))); err != nil {
+			return err
 		}
+		enumCount++
+	}
 
-		var buf bytes.Buffer
-		buf.WriteString("<?php\n\ndeclare(strict_types=1);\n\n")
-		fmt.Fprintf(&buf, "namespace %s;\n\n", typesNamespace)
-		buf.WriteString(g.buildPHPEnum(enum))
-
-		if _, err := f.Write(buf.Bytes()); err != nil {
-			_ = f.Close()
-			return fmt.Errorf("write file %q: %w", filename, err)
+	interfaceCount := 0
+	interfaceNames := slices.Collect(maps.Keys(g.interfaceSchemaNames))
+	slices.Sort(interfaceNames)
+	for _, className := range interfaceNames {
+		if g.schemaNamespaces[className] != g.typesNamespace() {
+			continue
 		}
-		_ = f.Close()
-		enumCount++
+		interfaceName := g.interfaceName(className)
+		if err := g.writePHPFile(path.Join(g.layout.Types, interfaceName+".php"), g.typesFile(g.buildPHPInterface(className))); err != nil {
+			return err
+		}
+		interfaceCount++
 	}
 
 	for _, schema := range schemas {
 		className := g.classNameForSchema(schema)
-		filename := filepath.Join(dir, fmt.Sprintf("%s.php", className))
-		f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
-		if err != nil {
-			return fmt.Errorf("open %q: %w", filename, err)
-		}
-
-		var buf bytes.Buffer
-		buf.WriteString("<?php\n\ndeclare(strict_types=1);\n\n")
-		fmt.Fprintf(&buf, "namespace %s;\n\n", typesNamespace)
-		buf.WriteString(g.buildPHPClass(className, schema, typesNamespace))
-
-		if _, err := f.Write(buf.Bytes()); err != nil {
-			_ = f.Close()
-			return fmt.Errorf("write file %q: %w", filename, err)
+		if err := g.writePHPFile(path.Join(g.layout.Types, className+".php"), g.typesFile(g.buildPHPClass(className, schema, g.typesNamespace()))); err != nil {
+			return err
 		}
-		_ = f.Close()
 	}
 
 	slog.Info("generated types",
 		slog.Int("classes", len(schemas)),
 		slog.Int("enums", enumCount),
-		slog.String("namespace", typesNamespace),
-		slog.String("dir", dir),
+		slog.Int("interfaces", interfaceCount),
+		slog.String("namespace", g.typesNamespace()),
+		slog.String("dir", filepath.Join(g.cfg.Out, g.layout.Types)),
 	)
 
 	return nil
 }
+
+// typesFile returns a file of the types namespace holding a single
+// declaration.
+func (g *Generator) typesFile(decl php.Decl) *php.File {
+	return &php.File{
+		StrictTypes: true,
+		Namespaces: []*php.Namespace{{
+			Name:  g.typesNamespace(),
+			Decls: []php.Decl{decl},
+		}},
+	}
+}
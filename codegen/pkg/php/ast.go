@@ -0,0 +1,116 @@
+// Package php models the PHP source files emitted by the generator: files,
+// namespaces, imports, classes, interfaces, enums and their members. The
+// printer renders them with a consistent layout and escapes string literals
+// and docblocks, so that values coming from the specs cannot break out of
+// the generated code.
+package php
+
+// File is a PHP source file made of one or more namespaces.
+type File struct {
+	// Comment is a line comment printed after the opening tag.
+	Comment string
+	// StrictTypes enables the strict_types declaration.
+	StrictTypes bool
+	Namespaces  []*Namespace
+}
+
+// Namespace is a namespace declaration followed by its imports and
+// declarations.
+type Namespace struct {
+	Name  string
+	Uses  []Use
+	Decls []Decl
+}
+
+// Use is a `use` import, optionally aliased.
+type Use struct {
+	Name  string
+	Alias string
+}
+
+// Decl is a top-level declaration: a class, an interface or an enum.
+type Decl interface {
+	print(p *printer)
+}
+
+// Expr is a PHP expression printed verbatim. Build literals with String,
+// Int or Bool to get them escaped.
+type Expr string
+
+// DocBlock is the text of a docblock, one entry per line. Entries may
+// contain line breaks, empty entries are blank lines.
+type DocBlock []string
+
+// Class is a class declaration.
+type Class struct {
+	Doc        DocBlock
+	Name       string
+	Final      bool
+	Readonly   bool
+	Extends    string
+	Implements []string
+	Traits     []string
+	Constants  []Constant
+	Properties []Property
+	Methods    []Method
+}
+
+// Interface is an interface declaration.
+type Interface struct {
+	Doc     DocBlock
+	Name    string
+	Extends []string
+}
+
+// Enum is an enum declaration, backed when BackingType is set.
+type Enum struct {
+	Doc         DocBlock
+	Name        string
+	BackingType string
+	Cases       []EnumCase
+}
+
+// EnumCase is a case of an enum. Value is empty for pure enums.
+type EnumCase struct {
+	Name  string
+	Value Expr
+}
+
+// Constant is a class constant. An empty visibility omits the modifier.
+type Constant struct {
+	Doc        DocBlock
+	Visibility string
+	Name       string
+	Value      Expr
+}
+
+// Property is a class property. The type is omitted when empty, and so is
+// the default value.
+type Property struct {
+	Doc        DocBlock
+	Visibility string
+	Readonly   bool
+	Type       string
+	Name       string
+	Default    Expr
+}
+
+// Method is a class method. Body holds the statements, indented relative to
+// the method body.
+type Method struct {
+	Doc        DocBlock
+	Visibility string
+	Static     bool
+	Name       string
+	Params     []Param
+	ReturnType string
+	Body       string
+}
+
+// Param is a function parameter. The type is omitted when empty, and so is
+// the default value.
+type Param struct {
+	Type    string
+	Name    string
+	Default Expr
+}
@@ -0,0 +1,255 @@
+package php
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ImportNames replaces the fully qualified class names referenced by the
+// declarations of the namespace, such as `\SumUp\Types\Checkout`, with short
+// names and adds the matching `use` imports. Names of the namespace itself
+// need no import. A name whose short form is taken by a declaration or by
+// another import gets an alias prefixed with its parent namespaces.
+//
+// Names are looked up in types, signatures, constant values, method bodies
+// and docblock tags, but neither in string literals nor in descriptions.
+// Global names such as `\ArrayObject` are left qualified.
+func (n *Namespace) ImportNames() {
+	referenced := make(map[string]struct{})
+	n.rewrite(func(name string) string {
+		referenced[name] = struct{}{}
+		return name
+	})
+	if len(referenced) == 0 {
+		return
+	}
+
+	// Short names already in use: declarations, existing imports and the
+	// names of the namespace itself, which always resolve unqualified.
+	taken := make(map[string]string)
+	for _, decl := range n.Decls {
+		taken[strings.ToLower(declName(decl))] = n.Name + `\` + declName(decl)
+	}
+	for _, use := range n.Uses {
+		taken[strings.ToLower(use.shortName())] = use.Name
+	}
+
+	names := make([]string, 0, len(referenced))
+	for name := range referenced {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	replacements := make(map[string]string, len(names))
+	for _, name := range names {
+		namespace, short := splitName(name)
+		if namespace == n.Name {
+			replacements[name] = short
+			taken[strings.ToLower(short)] = name
+		}
+	}
+	for _, use := range n.Uses {
+		replacements[`\`+use.Name] = use.shortName()
+	}
+
+	for _, name := range names {
+		if _, ok := replacements[name]; ok {
+			continue
+		}
+		alias := importAlias(strings.TrimPrefix(name, `\`), taken)
+		taken[strings.ToLower(alias)] = name
+		replacements[name] = alias
+
+		use := Use{Name: strings.TrimPrefix(name, `\`)}
+		if alias != use.shortName() {
+			use.Alias = alias
+		}
+		n.Uses = append(n.Uses, use)
+	}
+
+	slices.SortFunc(n.Uses, func(a, b Use) int {
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+
+	n.rewrite(func(name string) string {
+		return replacements[name]
+	})
+}
+
+func (u Use) shortName() string {
+	if u.Alias != "" {
+		return u.Alias
+	}
+	_, short := splitName(u.Name)
+	return short
+}
+
+// importAlias returns the short name of name, prefixed with as many parent
+// namespaces as needed to be free, or numbered as a last resort.
+func importAlias(name string, taken map[string]string) string {
+	parts := strings.Split(name, `\`)
+	for i := len(parts) - 1; i >= 0; i-- {
+		alias := strings.Join(parts[i:], "")
+		if _, ok := taken[strings.ToLower(alias)]; !ok {
+			return alias
+		}
+	}
+
+	alias := strings.Join(parts, "")
+	for suffix := 2; ; suffix++ {
+		numbered := alias + strconv.Itoa(suffix)
+		if _, ok := taken[strings.ToLower(numbered)]; !ok {
+			return numbered
+		}
+	}
+}
+
+// splitName splits a qualified name into its namespace and short name,
+// without leading backslashes.
+func splitName(name string) (string, string) {
+	name = strings.TrimPrefix(name, `\`)
+	idx := strings.LastIndex(name, `\`)
+	if idx < 0 {
+		return "", name
+	}
+	return name[:idx], name[idx+1:]
+}
+
+func declName(decl Decl) string {
+	switch d := decl.(type) {
+	case *Class:
+		return d.Name
+	case *Interface:
+		return d.Name
+	case *Enum:
+		return d.Name
+	}
+	return ""
+}
+
+// rewrite replaces every fully qualified name referenced by the namespace
+// declarations with the result of replace.
+func (n *Namespace) rewrite(replace func(string) string) {
+	code := func(value string) string {
+		return rewriteNames(value, replace, true)
+	}
+	expr := func(value Expr) Expr {
+		return Expr(code(string(value)))
+	}
+	doc := func(doc DocBlock) DocBlock {
+		result := make(DocBlock, len(doc))
+		for idx, entry := range doc {
+			result[idx] = rewriteDocTags(entry, replace)
+		}
+		return result
+	}
+	names := func(values []string) []string {
+		result := make([]string, len(values))
+		for idx, value := range values {
+			result[idx] = code(value)
+		}
+		return result
+	}
+
+	for _, decl := range n.Decls {
+		switch d := decl.(type) {
+		case *Class:
+			d.Doc = doc(d.Doc)
+			d.Extends = code(d.Extends)
+			d.Implements = names(d.Implements)
+			d.Traits = names(d.Traits)
+			for idx := range d.Constants {
+				d.Constants[idx].Doc = doc(d.Constants[idx].Doc)
+				d.Constants[idx].Value = expr(d.Constants[idx].Value)
+			}
+			for idx := range d.Properties {
+				d.Properties[idx].Doc = doc(d.Properties[idx].Doc)
+				d.Properties[idx].Type = code(d.Properties[idx].Type)
+				d.Properties[idx].Default = expr(d.Properties[idx].Default)
+			}
+			for idx := range d.Methods {
+				method := &d.Methods[idx]
+				method.Doc = doc(method.Doc)
+				for paramIdx := range method.Params {
+					method.Params[paramIdx].Type = code(method.Params[paramIdx].Type)
+					method.Params[paramIdx].Default = expr(method.Params[paramIdx].Default)
+				}
+				method.ReturnType = code(method.ReturnType)
+				method.Body = code(method.Body)
+			}
+		case *Interface:
+			d.Doc = doc(d.Doc)
+			d.Extends = names(d.Extends)
+		case *Enum:
+			d.Doc = doc(d.Doc)
+		}
+	}
+}
+
+// rewriteDocTags rewrites the names of docblock tag lines, leaving the
+// descriptions untouched.
+func rewriteDocTags(entry string, replace func(string) string) string {
+	lines := strings.Split(entry, "\n")
+	for idx, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "@") {
+			lines[idx] = rewriteNames(line, replace, false)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// rewriteNames replaces the fully qualified names of a piece of code, made of
+// at least two segments, optionally skipping string literals.
+func rewriteNames(code string, replace func(string) string, skipLiterals bool) string {
+	if !strings.Contains(code, `\`) {
+		return code
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(code); {
+		c := code[i]
+		switch {
+		case skipLiterals && (c == '\'' || c == '"'):
+			end := i + 1
+			for end < len(code) && code[end] != c {
+				if code[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end = min(end+1, len(code))
+			b.WriteString(code[i:end])
+			i = end
+		case c == '\\' && (i == 0 || !isNameByte(code[i-1])):
+			end := i
+			segments := 0
+			for end < len(code) && code[end] == '\\' && end+1 < len(code) && isNameStart(code[end+1]) {
+				end++
+				for end < len(code) && isNameByte(code[end]) {
+					end++
+				}
+				segments++
+			}
+			if segments < 2 {
+				b.WriteByte(c)
+				i++
+				continue
+			}
+			b.WriteString(replace(code[i:end]))
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+func isNameByte(c byte) bool {
+	return isNameStart(c) || c >= '0' && c <= '9'
+}
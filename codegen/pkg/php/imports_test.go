@@ -0,0 +1,50 @@
+package php
+
+import "testing"
+
+func TestImportNames(t *testing.T) {
+	t.Parallel()
+
+	namespace := &Namespace{
+		Name: "SumUp\\Services",
+		Decls: []Decl{&Class{
+			Name:       "Error",
+			Implements: []string{"\\SumUp\\Services\\SumUpService", "\\ArrayAccess"},
+			Methods: []Method{{
+				Doc: DocBlock{
+					"Returns \\SumUp\\Types\\Error as is.",
+					"@return \\SumUp\\Types\\Error",
+				},
+				Name:       "get",
+				Params:     []Param{{Type: "?\\SumUp\\HttpClient\\RequestOptions", Name: "options", Default: "null"}},
+				ReturnType: "\\SumUp\\Types\\Error",
+				Body:       "return \\SumUp\\Types\\Error::fromArray(['class' => '\\SumUp\\Types\\Error']);\n",
+			}},
+		}},
+	}
+	namespace.ImportNames()
+
+	got := Print(&File{Namespaces: []*Namespace{namespace}})
+	want := `<?php
+
+namespace SumUp\Services;
+
+use SumUp\HttpClient\RequestOptions;
+use SumUp\Types\Error as TypesError;
+
+class Error implements SumUpService, \ArrayAccess
+{
+    /**
+     * Returns \SumUp\Types\Error as is.
+     * @return TypesError
+     */
+    public function get(?RequestOptions $options = null): TypesError
+    {
+        return TypesError::fromArray(['class' => '\SumUp\Types\Error']);
+    }
+}
+`
+	if got != want {
+		t.Errorf("Print() =\n%s\nwant\n%s", got, want)
+	}
+}
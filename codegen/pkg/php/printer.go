@@ -0,0 +1,322 @@
+package php
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	indent = "    "
+
+	// lineLength is the length above which method parameters are wrapped,
+	// one per line.
+	lineLength = 120
+)
+
+// Print renders a file.
+func Print(f *File) string {
+	p := &printer{}
+	p.file(f)
+	return p.String()
+}
+
+// PrintDecl renders a single declaration.
+func PrintDecl(decl Decl) string {
+	p := &printer{}
+	decl.print(p)
+	return p.String()
+}
+
+// String returns the single-quoted literal of a string.
+func String(value string) Expr {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return Expr("'" + value + "'")
+}
+
+// Int returns the literal of an integer.
+func Int(value int64) Expr {
+	return Expr(strconv.FormatInt(value, 10))
+}
+
+// Bool returns the literal of a boolean.
+func Bool(value bool) Expr {
+	if value {
+		return "true"
+	}
+	return "false"
+}
+
+type printer struct {
+	strings.Builder
+}
+
+func (p *printer) file(f *File) {
+	p.WriteString("<?php\n\n")
+	if f.Comment != "" {
+		p.WriteString("// ")
+		p.WriteString(strings.ReplaceAll(f.Comment, "\n", " "))
+		p.WriteString("\n\n")
+	}
+	if f.StrictTypes {
+		p.WriteString("declare(strict_types=1);\n\n")
+	}
+	for idx, namespace := range f.Namespaces {
+		if idx > 0 {
+			p.WriteString("\n")
+		}
+		p.namespace(namespace)
+	}
+}
+
+func (p *printer) namespace(n *Namespace) {
+	p.WriteString("namespace ")
+	p.WriteString(n.Name)
+	p.WriteString(";\n")
+
+	if len(n.Uses) > 0 {
+		p.WriteString("\n")
+		for _, use := range n.Uses {
+			p.WriteString("use ")
+			p.WriteString(use.Name)
+			if use.Alias != "" {
+				p.WriteString(" as ")
+				p.WriteString(use.Alias)
+			}
+			p.WriteString(";\n")
+		}
+	}
+
+	for _, decl := range n.Decls {
+		p.WriteString("\n")
+		decl.print(p)
+	}
+}
+
+// docBlock prints a docblock, trimming every line, collapsing consecutive
+// blank lines and escaping comment terminators.
+func (p *printer) docBlock(doc DocBlock, prefix string) {
+	lines := make([]string, 0, len(doc))
+	for _, entry := range doc {
+		for _, line := range strings.Split(entry, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+				continue
+			}
+			lines = append(lines, strings.ReplaceAll(line, "*/", "*\\/"))
+		}
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	p.WriteString(prefix)
+	p.WriteString("/**\n")
+	for _, line := range lines {
+		p.WriteString(prefix)
+		if line == "" {
+			p.WriteString(" *\n")
+			continue
+		}
+		p.WriteString(" * ")
+		p.WriteString(line)
+		p.WriteString("\n")
+	}
+	p.WriteString(prefix)
+	p.WriteString(" */\n")
+}
+
+func (c *Class) print(p *printer) {
+	p.docBlock(c.Doc, "")
+	if c.Final {
+		p.WriteString("final ")
+	}
+	if c.Readonly {
+		p.WriteString("readonly ")
+	}
+	p.WriteString("class ")
+	p.WriteString(c.Name)
+	if c.Extends != "" {
+		p.WriteString(" extends ")
+		p.WriteString(c.Extends)
+	}
+	if len(c.Implements) > 0 {
+		p.WriteString(" implements ")
+		p.WriteString(strings.Join(c.Implements, ", "))
+	}
+	p.WriteString("\n{\n")
+
+	members := make([]member, 0, len(c.Traits)+len(c.Constants)+len(c.Properties)+len(c.Methods))
+	for _, trait := range c.Traits {
+		members = append(members, member{kind: "use", print: func(p *printer) {
+			p.WriteString(indent + "use " + trait + ";\n")
+		}})
+	}
+	for _, constant := range c.Constants {
+		members = append(members, member{kind: "const", documented: len(constant.Doc) > 0, print: constant.print})
+	}
+	for _, property := range c.Properties {
+		members = append(members, member{kind: "property", documented: len(property.Doc) > 0, print: property.print})
+	}
+	for _, method := range c.Methods {
+		members = append(members, member{kind: "method", documented: true, print: method.print})
+	}
+	p.members(members)
+
+	p.WriteString("}\n")
+}
+
+func (i *Interface) print(p *printer) {
+	p.docBlock(i.Doc, "")
+	p.WriteString("interface ")
+	p.WriteString(i.Name)
+	if len(i.Extends) > 0 {
+		p.WriteString(" extends ")
+		p.WriteString(strings.Join(i.Extends, ", "))
+	}
+	p.WriteString("\n{\n}\n")
+}
+
+func (e *Enum) print(p *printer) {
+	p.docBlock(e.Doc, "")
+	p.WriteString("enum ")
+	p.WriteString(e.Name)
+	if e.BackingType != "" {
+		p.WriteString(": ")
+		p.WriteString(e.BackingType)
+	}
+	p.WriteString("\n{\n")
+	for _, enumCase := range e.Cases {
+		p.WriteString(indent + "case " + enumCase.Name)
+		if enumCase.Value != "" {
+			p.WriteString(" = ")
+			p.WriteString(string(enumCase.Value))
+		}
+		p.WriteString(";\n")
+	}
+	p.WriteString("}\n")
+}
+
+// member is a class member. Members are separated by a blank line, except
+// consecutive undocumented members of the same kind, such as trait imports.
+type member struct {
+	kind       string
+	documented bool
+	print      func(p *printer)
+}
+
+func (p *printer) members(members []member) {
+	for idx, m := range members {
+		if idx > 0 {
+			previous := members[idx-1]
+			if previous.kind != m.kind || previous.documented || m.documented {
+				p.WriteString("\n")
+			}
+		}
+		m.print(p)
+	}
+}
+
+func (c Constant) print(p *printer) {
+	p.docBlock(c.Doc, indent)
+	p.WriteString(indent)
+	if c.Visibility != "" {
+		p.WriteString(c.Visibility)
+		p.WriteString(" ")
+	}
+	p.WriteString("const ")
+	p.WriteString(c.Name)
+	p.WriteString(" = ")
+	p.WriteString(string(c.Value))
+	p.WriteString(";\n")
+}
+
+func (prop Property) print(p *printer) {
+	p.docBlock(prop.Doc, indent)
+	p.WriteString(indent)
+	p.WriteString(visibility(prop.Visibility))
+	if prop.Readonly {
+		p.WriteString(" readonly")
+	}
+	p.WriteString(" ")
+	p.WriteString(Param{Type: prop.Type, Name: prop.Name, Default: prop.Default}.String())
+	p.WriteString(";\n")
+}
+
+func (m Method) print(p *printer) {
+	p.docBlock(m.Doc, indent)
+
+	var signature strings.Builder
+	signature.WriteString(indent)
+	signature.WriteString(visibility(m.Visibility))
+	if m.Static {
+		signature.WriteString(" static")
+	}
+	signature.WriteString(" function ")
+	signature.WriteString(m.Name)
+	returnType := ""
+	if m.ReturnType != "" {
+		returnType = ": " + m.ReturnType
+	}
+
+	params := make([]string, len(m.Params))
+	for idx, param := range m.Params {
+		params[idx] = param.String()
+	}
+	inline := signature.String() + "(" + strings.Join(params, ", ") + ")" + returnType
+	if len(inline) <= lineLength || len(params) == 0 {
+		p.WriteString(inline)
+		p.WriteString("\n" + indent + "{\n")
+	} else {
+		p.WriteString(signature.String())
+		p.WriteString("(\n")
+		for idx, param := range params {
+			p.WriteString(indent + indent + param)
+			if idx < len(params)-1 {
+				p.WriteString(",")
+			}
+			p.WriteString("\n")
+		}
+		p.WriteString(indent + ")" + returnType + " {\n")
+	}
+
+	body := strings.Trim(m.Body, "\n")
+	if body != "" {
+		for _, line := range strings.Split(body, "\n") {
+			if strings.TrimSpace(line) == "" {
+				p.WriteString("\n")
+				continue
+			}
+			p.WriteString(indent + indent)
+			p.WriteString(line)
+			p.WriteString("\n")
+		}
+	}
+	p.WriteString(indent + "}\n")
+}
+
+// String renders the parameter as it appears in a signature.
+func (param Param) String() string {
+	var b strings.Builder
+	if param.Type != "" {
+		b.WriteString(param.Type)
+		b.WriteString(" ")
+	}
+	b.WriteString("$")
+	b.WriteString(param.Name)
+	if param.Default != "" {
+		b.WriteString(" = ")
+		b.WriteString(string(param.Default))
+	}
+	return b.String()
+}
+
+func visibility(value string) string {
+	if value == "" {
+		return "public"
+	}
+	return value
+}
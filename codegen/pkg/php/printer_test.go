@@ -0,0 +1,130 @@
+package php
+
+import "testing"
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	for value, want := range map[string]Expr{
+		"EUR":         `'EUR'`,
+		"it's":        `'it\'s'`,
+		`C:\path`:     `'C:\\path'`,
+		`\'; exit; '`: `'\\\'; exit; \''`,
+	} {
+		if got := String(value); got != want {
+			t.Errorf("String(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
+
+func TestPrintEscapesDocBlocks(t *testing.T) {
+	t.Parallel()
+
+	got := PrintDecl(&Enum{
+		Doc:         DocBlock{"Closes early */ unless escaped.\n\n\nSecond paragraph.", ""},
+		Name:        "Quote",
+		BackingType: "string",
+		Cases:       []EnumCase{{Name: "SINGLE", Value: String("'")}},
+	})
+	want := `/**
+ * Closes early *\/ unless escaped.
+ *
+ * Second paragraph.
+ */
+enum Quote: string
+{
+    case SINGLE = '\'';
+}
+`
+	if got != want {
+		t.Errorf("PrintDecl() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestPrintFile(t *testing.T) {
+	t.Parallel()
+
+	got := Print(&File{
+		StrictTypes: true,
+		Namespaces: []*Namespace{{
+			Name: "SumUp\\Services",
+			Uses: []Use{
+				{Name: "SumUp\\HttpClient\\RequestOptions"},
+				{Name: "SumUp\\Types\\Checkout", Alias: "CheckoutModel"},
+			},
+			Decls: []Decl{&Class{
+				Name:   "Checkouts",
+				Traits: []string{"First", "Second"},
+				Constants: []Constant{
+					{Visibility: "public", Name: "A", Value: Int(1)},
+					{Visibility: "public", Name: "B", Value: Int(2)},
+				},
+				Properties: []Property{{
+					Doc:        DocBlock{"@var string|null"},
+					Visibility: "protected",
+					Type:       "?string",
+					Name:       "name",
+					Default:    "null",
+				}},
+				Methods: []Method{
+					{
+						Name:       "short",
+						ReturnType: "void",
+					},
+					{
+						Name: "long",
+						Params: []Param{
+							{Type: "string", Name: "merchantCode"},
+							{Type: "CheckoutModel|array", Name: "body"},
+							{Type: "?RequestOptions", Name: "requestOptions", Default: "null"},
+						},
+						ReturnType: "CheckoutModel",
+						Body:       "if ($body === []) {\n    return null;\n}\n\nreturn $body;\n",
+					},
+				},
+			}},
+		}},
+	})
+	want := `<?php
+
+declare(strict_types=1);
+
+namespace SumUp\Services;
+
+use SumUp\HttpClient\RequestOptions;
+use SumUp\Types\Checkout as CheckoutModel;
+
+class Checkouts
+{
+    use First;
+    use Second;
+
+    public const A = 1;
+    public const B = 2;
+
+    /**
+     * @var string|null
+     */
+    protected ?string $name = null;
+
+    public function short(): void
+    {
+    }
+
+    public function long(
+        string $merchantCode,
+        CheckoutModel|array $body,
+        ?RequestOptions $requestOptions = null
+    ): CheckoutModel {
+        if ($body === []) {
+            return null;
+        }
+
+        return $body;
+    }
+}
+`
+	if got != want {
+		t.Errorf("Print() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,43 @@
+package surface
+
+import (
+	"strings"
+)
+
+// changelogSections are the sections of a changelog draft, in order, with
+// the changes they list.
+var changelogSections = []struct {
+	title    string
+	includes func(Change) bool
+}{
+	{"⚠ BREAKING CHANGES", func(c Change) bool { return c.Breaking }},
+	{"Features", func(c Change) bool { return !c.Breaking && c.Kind == KindAdded }},
+	{"Deprecations", func(c Change) bool { return !c.Breaking && c.Kind == KindDeprecated }},
+	{"Changes", func(c Change) bool { return !c.Breaking && (c.Kind == KindChanged || c.Kind == KindRemoved) }},
+}
+
+// Changelog drafts the CHANGELOG.md entries of the changes, in the sections
+// used by the release notes. It returns "" when there are no changes.
+func Changelog(changes []Change) string {
+	var b strings.Builder
+	for _, section := range changelogSections {
+		var entries []string
+		for _, change := range changes {
+			if section.includes(change) {
+				entries = append(entries, change.Description)
+			}
+		}
+		if len(entries) == 0 {
+			continue
+		}
+
+		if b.Len() > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString("### " + section.title + "\n\n")
+		for _, entry := range entries {
+			b.WriteString("* " + entry + "\n")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,371 @@
+package surface
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Kind is the nature of a change.
+type Kind string
+
+const (
+	KindAdded      Kind = "added"
+	KindRemoved    Kind = "removed"
+	KindChanged    Kind = "changed"
+	KindDeprecated Kind = "deprecated"
+)
+
+// Change is a difference between two surfaces.
+type Change struct {
+	Kind Kind `json:"kind"`
+
+	// Breaking is set when code written against the previous surface may
+	// no longer run or type check against the current one.
+	Breaking bool `json:"breaking"`
+
+	// Symbol is the changed element, such as `SumUp\Types\Checkout`,
+	// `SumUp\Types\Checkout::$id` or `SumUp\Checkouts\Checkouts::create()`.
+	Symbol string `json:"symbol"`
+
+	// Description is a sentence describing the change.
+	Description string `json:"description"`
+}
+
+// Compare returns the changes from the surface before to the surface after,
+// breaking ones first, then sorted by symbol.
+func Compare(before, after Surface) []Change {
+	c := &comparison{}
+	c.classes("service", before.Services, after.Services)
+	c.classes("class", before.Classes, after.Classes)
+	c.interfaces(before.Interfaces, after.Interfaces)
+	c.enums(before.Enums, after.Enums)
+
+	slices.SortStableFunc(c.changes, func(a, b Change) int {
+		if a.Breaking != b.Breaking {
+			if a.Breaking {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.Symbol, b.Symbol)
+	})
+	return c.changes
+}
+
+type comparison struct {
+	changes []Change
+}
+
+func (c *comparison) add(kind Kind, breaking bool, symbol, format string, args ...any) {
+	c.changes = append(c.changes, Change{
+		Kind:        kind,
+		Breaking:    breaking,
+		Symbol:      symbol,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// match calls changed with the elements found in both lists, and added or
+// removed with the others.
+func match[T any](before, after []T, name func(T) string, added, removed func(T), changed func(before, after T)) {
+	byName := make(map[string]T, len(before))
+	for _, item := range before {
+		byName[name(item)] = item
+	}
+	for _, item := range after {
+		if previous, ok := byName[name(item)]; ok {
+			changed(previous, item)
+			delete(byName, name(item))
+			continue
+		}
+		added(item)
+	}
+	for _, item := range before {
+		if _, ok := byName[name(item)]; ok {
+			removed(item)
+		}
+	}
+}
+
+func (c *comparison) classes(label string, before, after []Class) {
+	match(before, after, func(class Class) string { return class.Name },
+		func(class Class) {
+			c.add(KindAdded, false, class.Name, "added %s `%s`", label, class.Name)
+		},
+		func(class Class) {
+			c.add(KindRemoved, true, class.Name, "removed %s `%s`", label, class.Name)
+		},
+		func(before, after Class) {
+			c.class(label, before, after)
+		},
+	)
+}
+
+func (c *comparison) class(label string, before, after Class) {
+	name := after.Name
+	switch {
+	case before.Extends != "" && before.Extends != after.Extends:
+		c.add(KindChanged, true, name, "changed the parent class of `%s` from `%s` to %s", name, before.Extends, codeOrNone(after.Extends))
+	case before.Extends == "" && after.Extends != "":
+		c.add(KindChanged, false, name, "made `%s` extend `%s`", name, after.Extends)
+	}
+	for _, iface := range before.Implements {
+		if !slices.Contains(after.Implements, iface) {
+			c.add(KindRemoved, true, name, "`%s` no longer implements `%s`", name, iface)
+		}
+	}
+	for _, iface := range after.Implements {
+		if !slices.Contains(before.Implements, iface) {
+			c.add(KindAdded, false, name, "`%s` implements `%s`", name, iface)
+		}
+	}
+	if !before.Final && after.Final {
+		c.add(KindChanged, true, name, "made %s `%s` final", label, name)
+	}
+	if !before.Readonly && after.Readonly {
+		c.add(KindChanged, true, name, "made %s `%s` readonly", label, name)
+	}
+	if before.Readonly && !after.Readonly {
+		c.add(KindChanged, false, name, "made %s `%s` mutable", label, name)
+	}
+	if !before.Deprecated && after.Deprecated {
+		c.add(KindDeprecated, false, name, "deprecated %s `%s`", label, name)
+	}
+
+	match(before.Constants, after.Constants, func(constant Constant) string { return constant.Name },
+		func(constant Constant) {
+			symbol := name + "::" + constant.Name
+			c.add(KindAdded, false, symbol, "added constant `%s`", symbol)
+		},
+		func(constant Constant) {
+			symbol := name + "::" + constant.Name
+			c.add(KindRemoved, true, symbol, "removed constant `%s`", symbol)
+		},
+		func(before, after Constant) {
+			// Code referencing the constant keeps working, such as the
+			// cases of an enum rendered for PHP 8.0 and below.
+			if before.Value != after.Value {
+				symbol := name + "::" + after.Name
+				c.add(KindChanged, false, symbol, "changed the value of `%s` from `%s` to `%s`", symbol, before.Value, after.Value)
+			}
+		},
+	)
+
+	match(before.Properties, after.Properties, func(property Property) string { return property.Name },
+		func(property Property) {
+			symbol := name + "::$" + property.Name
+			c.add(KindAdded, false, symbol, "added property `%s`", symbol)
+		},
+		func(property Property) {
+			symbol := name + "::$" + property.Name
+			c.add(KindRemoved, true, symbol, "removed property `%s`", symbol)
+		},
+		func(before, after Property) {
+			c.property(name+"::$"+after.Name, before, after)
+		},
+	)
+
+	match(before.Methods, after.Methods, func(method Method) string { return method.Name },
+		func(method Method) {
+			symbol := name + "::" + method.Name + "()"
+			c.add(KindAdded, false, symbol, "added method `%s`", symbol)
+		},
+		func(method Method) {
+			symbol := name + "::" + method.Name + "()"
+			c.add(KindRemoved, true, symbol, "removed method `%s`", symbol)
+		},
+		func(before, after Method) {
+			c.method(name+"::"+after.Name+"()", before, after)
+		},
+	)
+}
+
+// property compares two versions of a property, which is both read and
+// written by the SDK users, so that any type change is breaking.
+func (c *comparison) property(symbol string, before, after Property) {
+	if beforeType, afterType := typeString(before.Type, before.DocType), typeString(after.Type, after.DocType); beforeType != afterType {
+		c.add(KindChanged, true, symbol, "changed the type of `%s` from `%s` to `%s`", symbol, beforeType, afterType)
+	}
+	if !before.Readonly && after.Readonly {
+		c.add(KindChanged, true, symbol, "made `%s` readonly", symbol)
+	}
+	if !before.Deprecated && after.Deprecated {
+		c.add(KindDeprecated, false, symbol, "deprecated `%s`", symbol)
+	}
+}
+
+func (c *comparison) method(symbol string, before, after Method) {
+	if before.Static != after.Static {
+		c.add(KindChanged, true, symbol, "changed `%s` from %s to %s", symbol, staticLabel(before.Static), staticLabel(after.Static))
+	}
+	// Returning a subset of the previous types keeps the callers working.
+	if beforeType, afterType := typeString(before.ReturnType, before.DocReturnType), typeString(after.ReturnType, after.DocReturnType); beforeType != afterType {
+		breaking := !isSubtype(after.ReturnType, before.ReturnType) || !isSubtype(after.DocReturnType, before.DocReturnType)
+		c.add(KindChanged, breaking, symbol, "changed the return type of `%s` from `%s` to `%s`", symbol, beforeType, afterType)
+	}
+	if !before.Deprecated && after.Deprecated {
+		c.add(KindDeprecated, false, symbol, "deprecated `%s`", symbol)
+	}
+
+	// Parameters are compared by position, and by name since PHP 8
+	// supports named arguments.
+	for idx, param := range before.Params {
+		if idx >= len(after.Params) {
+			c.add(KindRemoved, true, symbol, "removed parameter `$%s` of `%s`", param.Name, symbol)
+			continue
+		}
+		c.param(symbol, param, after.Params[idx])
+	}
+	for _, param := range after.Params[min(len(before.Params), len(after.Params)):] {
+		if param.Optional {
+			c.add(KindAdded, false, symbol, "added optional parameter `$%s` to `%s`", param.Name, symbol)
+		} else {
+			c.add(KindAdded, true, symbol, "added required parameter `$%s` to `%s`", param.Name, symbol)
+		}
+	}
+}
+
+func (c *comparison) param(symbol string, before, after Param) {
+	if before.Name != after.Name {
+		c.add(KindChanged, true, symbol, "renamed parameter `$%s` of `%s` to `$%s`", before.Name, symbol, after.Name)
+	}
+	// Accepting a superset of the previous types keeps the callers working.
+	if beforeType, afterType := typeString(before.Type, before.DocType), typeString(after.Type, after.DocType); beforeType != afterType {
+		breaking := !isSubtype(before.Type, after.Type) || !isSubtype(before.DocType, after.DocType)
+		c.add(KindChanged, breaking, symbol, "changed the type of parameter `$%s` of `%s` from `%s` to `%s`", after.Name, symbol, beforeType, afterType)
+	}
+	switch {
+	case before.Optional && !after.Optional:
+		c.add(KindChanged, true, symbol, "made parameter `$%s` of `%s` required", after.Name, symbol)
+	case !before.Optional && after.Optional:
+		c.add(KindChanged, false, symbol, "made parameter `$%s` of `%s` optional", after.Name, symbol)
+	}
+}
+
+func (c *comparison) interfaces(before, after []Interface) {
+	match(before, after, func(iface Interface) string { return iface.Name },
+		func(iface Interface) {
+			c.add(KindAdded, false, iface.Name, "added interface `%s`", iface.Name)
+		},
+		func(iface Interface) {
+			c.add(KindRemoved, true, iface.Name, "removed interface `%s`", iface.Name)
+		},
+		func(before, after Interface) {
+			for _, parent := range before.Extends {
+				if !slices.Contains(after.Extends, parent) {
+					c.add(KindRemoved, true, after.Name, "`%s` no longer extends `%s`", after.Name, parent)
+				}
+			}
+			for _, parent := range after.Extends {
+				if !slices.Contains(before.Extends, parent) {
+					c.add(KindAdded, false, after.Name, "`%s` extends `%s`", after.Name, parent)
+				}
+			}
+		},
+	)
+}
+
+func (c *comparison) enums(before, after []Enum) {
+	match(before, after, func(enum Enum) string { return enum.Name },
+		func(enum Enum) {
+			c.add(KindAdded, false, enum.Name, "added enum `%s`", enum.Name)
+		},
+		func(enum Enum) {
+			c.add(KindRemoved, true, enum.Name, "removed enum `%s`", enum.Name)
+		},
+		func(before, after Enum) {
+			name := after.Name
+			if before.BackingType != after.BackingType {
+				c.add(KindChanged, true, name, "changed the backing type of `%s` from %s to %s", name, codeOrNone(before.BackingType), codeOrNone(after.BackingType))
+			}
+			match(before.Cases, after.Cases, func(enumCase Case) string { return enumCase.Name },
+				func(enumCase Case) {
+					symbol := name + "::" + enumCase.Name
+					c.add(KindAdded, false, symbol, "added case `%s`", symbol)
+				},
+				func(enumCase Case) {
+					symbol := name + "::" + enumCase.Name
+					c.add(KindRemoved, true, symbol, "removed case `%s`", symbol)
+				},
+				func(before, after Case) {
+					if before.Value != after.Value {
+						symbol := name + "::" + after.Name
+						c.add(KindChanged, true, symbol, "changed the value of `%s` from `%s` to `%s`", symbol, before.Value, after.Value)
+					}
+				},
+			)
+		},
+	)
+}
+
+// typeString renders a type along with its documented type, when any.
+func typeString(native, doc string) string {
+	switch {
+	case native == "" && doc == "":
+		return "mixed"
+	case doc == "":
+		return native
+	case native == "":
+		return doc
+	}
+	return native + " (" + doc + ")"
+}
+
+// isSubtype reports whether every member of the union sub is a member of the
+// union super. An empty type is the implicit mixed type.
+func isSubtype(sub, super string) bool {
+	if super == "" || super == "mixed" {
+		return true
+	}
+	if sub == "" {
+		return false
+	}
+	members := unionMembers(super)
+	for _, member := range unionMembers(sub) {
+		if !slices.Contains(members, member) {
+			return false
+		}
+	}
+	return true
+}
+
+// unionMembers splits a union type on its top-level pipes, spelling out the
+// nullable shorthand.
+func unionMembers(typ string) []string {
+	if rest, ok := strings.CutPrefix(typ, "?"); ok {
+		return append(unionMembers(rest), "null")
+	}
+
+	var members []string
+	depth, start := 0, 0
+	for idx, c := range typ {
+		switch c {
+		case '<', '(', '{':
+			depth++
+		case '>', ')', '}':
+			depth--
+		case '|':
+			if depth == 0 {
+				members = append(members, strings.TrimSpace(typ[start:idx]))
+				start = idx + 1
+			}
+		}
+	}
+	return append(members, strings.TrimSpace(typ[start:]))
+}
+
+func codeOrNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return "`" + value + "`"
+}
+
+func staticLabel(static bool) string {
+	if static {
+		return "static"
+	}
+	return "instance"
+}
@@ -0,0 +1,119 @@
+package surface
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	before := Surface{
+		Services: []Class{{
+			Name: `SumUp\Services\Checkouts`,
+			Methods: []Method{
+				{
+					Name: "create",
+					Params: []Param{
+						{Name: "body", Type: `SumUp\Types\CheckoutCreateRequest`},
+						{Name: "requestOptions", Type: `?SumUp\HttpClient\RequestOptions`, Optional: true},
+					},
+					ReturnType: `SumUp\Types\Checkout`,
+				},
+				{Name: "deactivate", Params: []Param{{Name: "id", Type: "string"}}, ReturnType: "mixed"},
+				{Name: "list", ReturnType: "array", DocReturnType: `SumUp\Types\Checkout[]`},
+			},
+		}},
+		Classes: []Class{
+			{
+				Name: `SumUp\Types\Checkout`,
+				Properties: []Property{
+					{Name: "id", Type: "?string"},
+					{Name: "amount", Type: "?float"},
+				},
+			},
+			{Name: `SumUp\Types\Legacy`},
+		},
+		Enums: []Enum{{
+			Name:        `SumUp\Types\Currency`,
+			BackingType: "string",
+			Cases:       []Case{{Name: "EUR", Value: "'EUR'"}, {Name: "HRK", Value: "'HRK'"}},
+		}},
+	}
+	after := Surface{
+		Services: []Class{{
+			Name: `SumUp\Services\Checkouts`,
+			Methods: []Method{
+				{
+					Name: "create",
+					Params: []Param{
+						{Name: "body", Type: `SumUp\Types\CheckoutCreateRequest|array`},
+						{Name: "requestOptions", Type: `?SumUp\HttpClient\RequestOptions`, Optional: true},
+						{Name: "idempotencyKey", Type: "?string", Optional: true},
+					},
+					ReturnType: `SumUp\Types\Checkout`,
+					Deprecated: true,
+				},
+				{Name: "deactivate", Params: []Param{{Name: "checkoutId", Type: "string"}}, ReturnType: `SumUp\Types\Checkout`},
+				{Name: "list", ReturnType: "array", DocReturnType: `SumUp\Types\CheckoutSuccess[]`},
+			},
+		}},
+		Classes: []Class{{
+			Name: `SumUp\Types\Checkout`,
+			Properties: []Property{
+				{Name: "id", Type: "string"},
+				{Name: "amount", Type: "?float"},
+				{Name: "status", Type: "?string"},
+			},
+		}},
+		Enums: []Enum{{
+			Name:        `SumUp\Types\Currency`,
+			BackingType: "string",
+			Cases:       []Case{{Name: "EUR", Value: "'EUR'"}, {Name: "USD", Value: "'USD'"}},
+		}},
+	}
+
+	var got []string
+	for _, change := range Compare(before, after) {
+		label := "non-breaking"
+		if change.Breaking {
+			label = "breaking"
+		}
+		got = append(got, label+": "+change.Description)
+	}
+	want := []string{
+		"breaking: renamed parameter `$id` of `SumUp\\Services\\Checkouts::deactivate()` to `$checkoutId`",
+		"breaking: changed the return type of `SumUp\\Services\\Checkouts::list()` from `array (SumUp\\Types\\Checkout[])` to `array (SumUp\\Types\\CheckoutSuccess[])`",
+		"breaking: changed the type of `SumUp\\Types\\Checkout::$id` from `?string` to `string`",
+		"breaking: removed case `SumUp\\Types\\Currency::HRK`",
+		"breaking: removed class `SumUp\\Types\\Legacy`",
+		"non-breaking: deprecated `SumUp\\Services\\Checkouts::create()`",
+		"non-breaking: changed the type of parameter `$body` of `SumUp\\Services\\Checkouts::create()` from `SumUp\\Types\\CheckoutCreateRequest` to `SumUp\\Types\\CheckoutCreateRequest|array`",
+		"non-breaking: added optional parameter `$idempotencyKey` to `SumUp\\Services\\Checkouts::create()`",
+		"non-breaking: changed the return type of `SumUp\\Services\\Checkouts::deactivate()` from `mixed` to `SumUp\\Types\\Checkout`",
+		"non-breaking: added property `SumUp\\Types\\Checkout::$status`",
+		"non-breaking: added case `SumUp\\Types\\Currency::USD`",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compare() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestChangelog(t *testing.T) {
+	t.Parallel()
+
+	got := Changelog([]Change{
+		{Kind: KindRemoved, Breaking: true, Description: "removed class `SumUp\\Types\\Legacy`"},
+		{Kind: KindAdded, Description: "added service `SumUp\\Services\\Roles`"},
+		{Kind: KindDeprecated, Description: "deprecated `SumUp\\Types\\Member::$permissions`"},
+	})
+	want := "### ⚠ BREAKING CHANGES\n\n" +
+		"* removed class `SumUp\\Types\\Legacy`\n\n\n" +
+		"### Features\n\n" +
+		"* added service `SumUp\\Services\\Roles`\n\n\n" +
+		"### Deprecations\n\n" +
+		"* deprecated `SumUp\\Types\\Member::$permissions`\n"
+	if got != want {
+		t.Errorf("Changelog() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+// Package surface describes the public API of the generated SDK, so that two
+// generations can be compared and their breaking changes spotted before a
+// release.
+package surface
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Surface is the public API of the generated SDK. Names are fully qualified,
+// without leading backslash, and every list is sorted by name.
+type Surface struct {
+	// Services are the classes calling the API operations.
+	Services []Class `json:"services"`
+
+	// Classes are the models, request bodies and query parameter classes.
+	Classes []Class `json:"classes"`
+
+	Interfaces []Interface `json:"interfaces"`
+
+	Enums []Enum `json:"enums"`
+}
+
+// Class is a class along with its public members.
+type Class struct {
+	Name       string     `json:"name"`
+	Extends    string     `json:"extends,omitempty"`
+	Implements []string   `json:"implements,omitempty"`
+	Final      bool       `json:"final,omitempty"`
+	Readonly   bool       `json:"readonly,omitempty"`
+	Deprecated bool       `json:"deprecated,omitempty"`
+	Constants  []Constant `json:"constants,omitempty"`
+	Properties []Property `json:"properties,omitempty"`
+	Methods    []Method   `json:"methods,omitempty"`
+}
+
+// Interface is an interface and the interfaces it extends.
+type Interface struct {
+	Name    string   `json:"name"`
+	Extends []string `json:"extends,omitempty"`
+}
+
+// Enum is a native enum.
+type Enum struct {
+	Name        string `json:"name"`
+	BackingType string `json:"backing_type,omitempty"`
+	Cases       []Case `json:"cases"`
+}
+
+// Case is an enum case. Value is the PHP literal of a backed case.
+type Case struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+// Constant is a public class constant. Value is its PHP expression.
+type Constant struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Property is a public property. DocType is the type of its @var tag, when
+// more precise than the native type, such as `Checkout[]` for `array`.
+type Property struct {
+	Name       string `json:"name"`
+	Type       string `json:"type,omitempty"`
+	DocType    string `json:"doc_type,omitempty"`
+	Readonly   bool   `json:"readonly,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+}
+
+// Method is a public method. Its parameters keep their declaration order.
+type Method struct {
+	Name          string  `json:"name"`
+	Static        bool    `json:"static,omitempty"`
+	Params        []Param `json:"params,omitempty"`
+	ReturnType    string  `json:"return_type,omitempty"`
+	DocReturnType string  `json:"doc_return_type,omitempty"`
+	Deprecated    bool    `json:"deprecated,omitempty"`
+}
+
+// Param is a method parameter. Optional parameters have a default value.
+type Param struct {
+	Name     string `json:"name"`
+	Type     string `json:"type,omitempty"`
+	DocType  string `json:"doc_type,omitempty"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// Read reads a surface dumped as JSON.
+func Read(filename string) (Surface, error) {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return Surface{}, fmt.Errorf("read surface: %w", err)
+	}
+
+	var s Surface
+	if err := json.Unmarshal(contents, &s); err != nil {
+		return Surface{}, fmt.Errorf("decode surface %q: %w", filename, err)
+	}
+	return s, nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/sumup/sumup-php/codegen/pkg/generator"
+)
+
+func Rehash() *cli.Command {
+	var out string
+	return &cli.Command{
+		Name:  "rehash",
+		Usage: "Record the generated files in the manifest as they are now, such as once formatted, so that the next generation prunes them",
+		Action: func(c *cli.Context) error {
+			if err := generator.New(generator.Config{Out: out}).RehashManifest(); err != nil {
+				return fmt.Errorf("rehash %q: %w", out, err)
+			}
+			return nil
+		},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "out",
+				Aliases:     []string{"o"},
+				Usage:       "path of the output directory",
+				Destination: &out,
+				Value:       "../src/",
+			},
+		},
+	}
+}
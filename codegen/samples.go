@@ -7,20 +7,21 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/pb33f/libopenapi"
 	"github.com/urfave/cli/v2"
-
-	"github.com/sumup/sumup-php/codegen/pkg/generator"
 )
 
 func Samples() *cli.Command {
 	var out string
 	var sdkVersion string
 	var sdkVersionFile string
+	var configFile string
+	var overlays cli.StringSlice
+	var filters filterOptions
 	return &cli.Command{
-		Name:  "samples",
-		Usage: "Generate PHP code samples as a JSON catalog",
-		Args:  true,
+		Name:      "samples",
+		Usage:     "Generate PHP code samples as a JSON catalog",
+		Args:      true,
+		ArgsUsage: "openapi.json [more specs...]",
 		Action: func(c *cli.Context) error {
 			if !c.Args().Present() {
 				return fmt.Errorf("empty argument, path to openapi specs expected")
@@ -36,22 +37,15 @@ func Samples() *cli.Command {
 				return fmt.Errorf("missing SDK version: set --sdk-version or --sdk-version-file")
 			}
 
-			spec, err := os.ReadFile(c.Args().First())
-			if err != nil {
-				return fmt.Errorf("read specs: %w", err)
-			}
-			document, err := libopenapi.NewDocument(spec)
+			cfg, err := readConfig(configFile)
 			if err != nil {
-				return fmt.Errorf("load openapi document: %w", err)
-			}
-			model, err := document.BuildV3Model()
-			if err != nil {
-				return fmt.Errorf("build openapi v3 model: %w", err)
+				return err
 			}
+			filters.apply(c, &cfg)
 
-			g := generator.New(generator.Config{})
-			if err := g.Load(&model.Model); err != nil {
-				return fmt.Errorf("load specs: %w", err)
+			g, err := loadGenerator(c.Args().Slice(), overlays.Value(), cfg)
+			if err != nil {
+				return err
 			}
 			catalog, err := g.Samples(sdkVersion)
 			if err != nil {
@@ -68,9 +62,11 @@ func Samples() *cli.Command {
 			if stdout == nil {
 				stdout = os.Stdout
 			}
-			return writeSamples(out, encoded, stdout)
+			return writeOutput(out, encoded, stdout)
 		},
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
+			configFlag(&configFile),
+			overlayFlag(&overlays),
 			&cli.StringFlag{
 				Name:        "out",
 				Aliases:     []string{"o"},
@@ -87,14 +83,16 @@ func Samples() *cli.Command {
 				Usage:       "composer.json file containing the SDK version",
 				Destination: &sdkVersionFile,
 			},
-		},
+		}, filters.flags()...),
 	}
 }
 
-func writeSamples(out string, encoded []byte, stdout io.Writer) error {
+// writeOutput writes the encoded output of a command to the out file, or to
+// stdout when out is empty.
+func writeOutput(out string, encoded []byte, stdout io.Writer) error {
 	if out == "" {
 		if _, err := stdout.Write(encoded); err != nil {
-			return fmt.Errorf("write samples: %w", err)
+			return fmt.Errorf("write output: %w", err)
 		}
 		return nil
 	}
@@ -104,7 +102,7 @@ func writeSamples(out string, encoded []byte, stdout io.Writer) error {
 		return fmt.Errorf("create output directory %q: %w", dir, err)
 	}
 	if err := os.WriteFile(out, encoded, 0o644); err != nil {
-		return fmt.Errorf("write samples %q: %w", out, err)
+		return fmt.Errorf("write output %q: %w", out, err)
 	}
 	return nil
 }
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+)
+
+func Surface() *cli.Command {
+	var out string
+	var configFile string
+	var overlays cli.StringSlice
+	var render renderOptions
+	var filters filterOptions
+	return &cli.Command{
+		Name:      "surface",
+		Usage:     "Dump the public API of the generated PHP SDK as JSON",
+		Args:      true,
+		ArgsUsage: "openapi.json [more specs...]",
+		Action: func(c *cli.Context) error {
+			if !c.Args().Present() {
+				return fmt.Errorf("empty argument, path to openapi specs expected")
+			}
+
+			cfg, err := readConfig(configFile)
+			if err != nil {
+				return err
+			}
+			render.apply(c, &cfg)
+			filters.apply(c, &cfg)
+
+			g, err := loadGenerator(c.Args().Slice(), overlays.Value(), cfg)
+			if err != nil {
+				return err
+			}
+			surface, err := g.Surface()
+			if err != nil {
+				return fmt.Errorf("build surface: %w", err)
+			}
+
+			encoded, err := json.MarshalIndent(surface, "", "  ")
+			if err != nil {
+				return fmt.Errorf("encode surface: %w", err)
+			}
+			encoded = append(encoded, '\n')
+
+			stdout := c.App.Writer
+			if stdout == nil {
+				stdout = os.Stdout
+			}
+			return writeOutput(out, encoded, stdout)
+		},
+		Flags: append([]cli.Flag{
+			configFlag(&configFile),
+			overlayFlag(&overlays),
+			&cli.StringFlag{
+				Name:        "out",
+				Aliases:     []string{"o"},
+				Usage:       "path of the output JSON file (defaults to stdout)",
+				Destination: &out,
+			},
+		}, append(render.flags(), filters.flags()...)...),
+	}
+}
@@ -0,0 +1,21 @@
+<?php
+
+namespace SumUp;
+
+/**
+ * Interface AdditionalPropertiesInterface
+ *
+ * Implemented by DTOs that keep payload fields without a matching property,
+ * such as fields added to the API after the SDK was generated.
+ *
+ * @package SumUp
+ */
+interface AdditionalPropertiesInterface
+{
+    /**
+     * Payload fields without a matching property, keyed by their serialized name.
+     *
+     * @return array<string, mixed>
+     */
+    public function additionalProperties(): array;
+}
@@ -0,0 +1,40 @@
+<?php
+
+namespace SumUp;
+
+/**
+ * Trait AdditionalPropertiesTrait
+ *
+ * Default implementation of AdditionalPropertiesInterface for generated DTOs.
+ *
+ * @package SumUp
+ */
+trait AdditionalPropertiesTrait
+{
+    /**
+     * Payload fields without a matching property.
+     *
+     * @var array<string, mixed>
+     */
+    private array $additionalProperties = [];
+
+    /**
+     * Payload fields without a matching property, keyed by their serialized name.
+     *
+     * @return array<string, mixed>
+     */
+    public function additionalProperties(): array
+    {
+        return $this->additionalProperties;
+    }
+
+    /**
+     * Replace the payload fields without a matching property.
+     *
+     * @param array<string, mixed> $additionalProperties
+     */
+    public function setAdditionalProperties(array $additionalProperties): void
+    {
+        $this->additionalProperties = $additionalProperties;
+    }
+}
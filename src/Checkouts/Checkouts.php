@@ -6,14 +6,32 @@ namespace SumUp\Checkouts;
 
 namespace SumUp\Services;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+use SumUp\Exception\ApiException;
+use SumUp\Exception\ConnectionException;
+use SumUp\Exception\SDKException;
+use SumUp\Exception\UnexpectedApiException;
+use SumUp\ExplicitFieldsInterface;
 use SumUp\HttpClient\HttpClientInterface;
 use SumUp\HttpClient\RequestHeaders;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\RequestEncoder;
 use SumUp\ResponseDecoder;
-
-class CheckoutsCreateApplePaySessionRequest
+use SumUp\Types\Checkout;
+use SumUp\Types\CheckoutCreateRequest;
+use SumUp\Types\CheckoutSuccess;
+use SumUp\Types\CheckoutUpdateRequest;
+use SumUp\Types\DetailsError;
+use SumUp\Types\Error;
+use SumUp\Types\ErrorExtended;
+use SumUp\Types\ErrorForbidden;
+use SumUp\Types\Problem;
+
+class CheckoutsCreateApplePaySessionRequest implements AdditionalPropertiesInterface, ExplicitFieldsInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * the context to create this apple pay session.
      *
@@ -28,20 +46,37 @@ class CheckoutsCreateApplePaySessionRequest
      */
     public string $target;
 
+    /**
+     * Properties explicitly set through fromArray(), setters, or the constructor with a non-null value.
+     *
+     * @var array<string, true>
+     */
+    private array $explicitFields = [];
+
     /**
      * Create request DTO.
      *
+     * Null arguments are left out of the payload. Send an explicit null
+     * through a setter or fromArray() to clear a field.
+     *
      * @param string $context
      * @param string $target
      */
-    public function __construct(
-        string $context,
-        string $target
-    ) {
-        \SumUp\Hydrator::hydrate([
+    public function __construct(string $context, string $target)
+    {
+        $this->fill([
             'context' => $context,
             'target' => $target,
-        ], self::class, $this);
+        ]);
+
+        foreach ([
+            'context' => $context,
+            'target' => $target,
+        ] as $propertyName => $value) {
+            if ($value !== null) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
     }
 
     /**
@@ -51,13 +86,17 @@ class CheckoutsCreateApplePaySessionRequest
      */
     public static function fromArray(array $data): self
     {
-        self::assertRequiredFields($data, [
+        $required = self::requiredFields($data, [
             'context' => 'context',
             'target' => 'target',
         ]);
 
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self(
+            (string) $required['context'],
+            (string) $required['target']
+        );
+        $request->fill($data);
+        $request->markExplicitFields($data);
 
         return $request;
     }
@@ -65,20 +104,129 @@ class CheckoutsCreateApplePaySessionRequest
     /**
      * @param array<string, mixed> $data
      * @param array<string, string> $requiredFields
+     *
+     * @return array<string, mixed>
      */
-    private static function assertRequiredFields(array $data, array $requiredFields): void
+    private static function requiredFields(array $data, array $requiredFields): array
     {
+        $values = [];
         foreach ($requiredFields as $serializedName => $propertyName) {
-            if (!array_key_exists($serializedName, $data) && !array_key_exists($propertyName, $data)) {
+            if (array_key_exists($serializedName, $data)) {
+                $values[$propertyName] = $data[$serializedName];
+            } elseif (array_key_exists($propertyName, $data)) {
+                $values[$propertyName] = $data[$propertyName];
+            } else {
                 throw new \InvalidArgumentException(sprintf('Missing required field "%s".', $serializedName));
             }
         }
+
+        return $values;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'context':
+                    $this->context = (string) $value;
+                    break;
+                case 'target':
+                    $this->target = (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->context)) {
+            $data['context'] = $this->context;
+        } elseif (isset($this->explicitFields['context'])) {
+            $data['context'] = null;
+        }
+        if (isset($this->target)) {
+            $data['target'] = $this->target;
+        } elseif (isset($this->explicitFields['target'])) {
+            $data['target'] = null;
+        }
+
+        return $data + $this->additionalProperties();
     }
 
+    /**
+     * Set context.
+     *
+     * @param string $context
+     */
+    public function setContext(string $context): self
+    {
+        $this->fill(['context' => $context]);
+        $this->explicitFields['context'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set target.
+     *
+     * @param string $target
+     */
+    public function setTarget(string $target): self
+    {
+        $this->fill(['target' => $target]);
+        $this->explicitFields['target'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Names of the properties explicitly set, including those set to null.
+     *
+     * @return string[]
+     */
+    public function explicitFields(): array
+    {
+        return array_keys($this->explicitFields);
+    }
+
+    /**
+     * @param array<string, mixed> $data
+     */
+    private function markExplicitFields(array $data): void
+    {
+        foreach ([
+            'context' => 'context',
+            'target' => 'target',
+        ] as $serializedName => $propertyName) {
+            if (array_key_exists($serializedName, $data) || array_key_exists($propertyName, $data)) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
+    }
 }
 
-class CheckoutsListAvailablePaymentMethodsResponse
+class CheckoutsListAvailablePaymentMethodsResponse implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Payment methods available to the merchant for the checkout.
      *
@@ -86,10 +234,63 @@ class CheckoutsListAvailablePaymentMethodsResponse
      */
     public ?array $availablePaymentMethods = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'available_payment_methods':
+                case 'availablePaymentMethods':
+                    $this->availablePaymentMethods = $value === null ? null : array_map(static fn ($item) => $item instanceof CheckoutsListAvailablePaymentMethodsResponseItem ? $item : CheckoutsListAvailablePaymentMethodsResponseItem::fromArray($item), (array) $value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->availablePaymentMethods)) {
+            $data['available_payment_methods'] = array_map(static fn (CheckoutsListAvailablePaymentMethodsResponseItem $item) => $item->toArray(), $this->availablePaymentMethods);
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
-class CheckoutsListAvailablePaymentMethodsResponseItem
+class CheckoutsListAvailablePaymentMethodsResponseItem implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique identifier of the payment method.
      *
@@ -97,6 +298,56 @@ class CheckoutsListAvailablePaymentMethodsResponseItem
      */
     public string $id;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
 /**
@@ -112,7 +363,6 @@ class CheckoutsListParams
      * @var string|null
      */
     public ?string $checkoutReference = null;
-
 }
 
 /**
@@ -135,7 +385,6 @@ class CheckoutsListAvailablePaymentMethodsParams
      * @var string|null
      */
     public ?string $currency = null;
-
 }
 
 /**
@@ -189,22 +438,22 @@ class Checkouts implements SumUpService
     /**
      * Create a checkout
      *
-     * @param \SumUp\Types\CheckoutCreateRequest|array<string, mixed> $body Required request payload
+     * @param CheckoutCreateRequest|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Checkout
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Checkout
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function create(\SumUp\Types\CheckoutCreateRequest|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\Checkout
+    public function create(CheckoutCreateRequest|array $body, ?RequestOptions $requestOptions = null): Checkout
     {
         $path = '/v0.1/checkouts';
         $payload = [];
         $requestBody = $body;
         if (is_array($requestBody)) {
-            $requestBody = \SumUp\Types\CheckoutCreateRequest::fromArray($requestBody);
+            $requestBody = CheckoutCreateRequest::fromArray($requestBody);
         }
         $payload = RequestEncoder::encode($requestBody);
         $headers = RequestHeaders::build($this->accessToken, $requestOptions);
@@ -212,12 +461,12 @@ class Checkouts implements SumUpService
         $response = $this->client->send('POST', $path, $payload, $headers, $requestOptions);
 
         return ResponseDecoder::decodeOrThrow($response, [
-            '201' => ['type' => 'class', 'class' => \SumUp\Types\Checkout::class],
+            '201' => ['type' => 'class', 'class' => Checkout::class],
         ], [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\ErrorExtended::class],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '403' => ['type' => 'class', 'class' => \SumUp\Types\ErrorForbidden::class],
-            '409' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+            '400' => ['type' => 'class', 'class' => ErrorExtended::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '403' => ['type' => 'class', 'class' => ErrorForbidden::class],
+            '409' => ['type' => 'class', 'class' => Error::class],
         ], 'POST', $path);
     }
 
@@ -229,13 +478,16 @@ class Checkouts implements SumUpService
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
      * @return array<string, mixed>
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function createApplePaySession(string $checkoutId, CheckoutsCreateApplePaySessionRequest|array|null $body = null, ?RequestOptions $requestOptions = null): array
-    {
+    public function createApplePaySession(
+        string $checkoutId,
+        CheckoutsCreateApplePaySessionRequest|array|null $body = null,
+        ?RequestOptions $requestOptions = null
+    ): array {
         $path = sprintf('/v0.2/checkouts/%s/apple-pay-session', rawurlencode((string) $checkoutId));
         $payload = [];
         if ($body !== null) {
@@ -253,7 +505,7 @@ class Checkouts implements SumUpService
             '200' => ['type' => 'object'],
         ], [
             '400' => ['type' => 'mixed'],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+            '404' => ['type' => 'class', 'class' => Error::class],
         ], 'PUT', $path);
     }
 
@@ -263,13 +515,13 @@ class Checkouts implements SumUpService
      * @param string $checkoutId Unique identifier of the checkout resource.
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Checkout
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Checkout
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function deactivate(string $checkoutId, ?RequestOptions $requestOptions = null): \SumUp\Types\Checkout
+    public function deactivate(string $checkoutId, ?RequestOptions $requestOptions = null): Checkout
     {
         $path = sprintf('/v0.1/checkouts/%s', rawurlencode((string) $checkoutId));
         $payload = [];
@@ -277,10 +529,10 @@ class Checkouts implements SumUpService
 
         $response = $this->client->send('DELETE', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Checkout::class, [
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
-            '409' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+        return ResponseDecoder::decodeOrThrow($response, Checkout::class, [
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Error::class],
+            '409' => ['type' => 'class', 'class' => Error::class],
         ], 'DELETE', $path);
     }
 
@@ -290,13 +542,13 @@ class Checkouts implements SumUpService
      * @param string $checkoutId Unique identifier of the checkout resource.
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\CheckoutSuccess
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return CheckoutSuccess
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function get(string $checkoutId, ?RequestOptions $requestOptions = null): \SumUp\Types\CheckoutSuccess
+    public function get(string $checkoutId, ?RequestOptions $requestOptions = null): CheckoutSuccess
     {
         $path = sprintf('/v0.1/checkouts/%s', rawurlencode((string) $checkoutId));
         $payload = [];
@@ -304,9 +556,9 @@ class Checkouts implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\CheckoutSuccess::class, [
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+        return ResponseDecoder::decodeOrThrow($response, CheckoutSuccess::class, [
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Error::class],
         ], 'GET', $path);
     }
 
@@ -316,11 +568,11 @@ class Checkouts implements SumUpService
      * @param CheckoutsListParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\CheckoutSuccess[]
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return CheckoutSuccess[]
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
     public function list(?CheckoutsListParams $queryParams = null, ?RequestOptions $requestOptions = null): array
     {
@@ -343,9 +595,9 @@ class Checkouts implements SumUpService
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
         return ResponseDecoder::decodeOrThrow($response, [
-            '200' => ['type' => 'array', 'items' => ['type' => 'class', 'class' => \SumUp\Types\CheckoutSuccess::class]],
+            '200' => ['type' => 'array', 'items' => ['type' => 'class', 'class' => CheckoutSuccess::class]],
         ], [
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -356,14 +608,17 @@ class Checkouts implements SumUpService
      * @param CheckoutsListAvailablePaymentMethodsParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Services\CheckoutsListAvailablePaymentMethodsResponse
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return CheckoutsListAvailablePaymentMethodsResponse
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function listAvailablePaymentMethods(string $merchantCode, ?CheckoutsListAvailablePaymentMethodsParams $queryParams = null, ?RequestOptions $requestOptions = null): \SumUp\Services\CheckoutsListAvailablePaymentMethodsResponse
-    {
+    public function listAvailablePaymentMethods(
+        string $merchantCode,
+        ?CheckoutsListAvailablePaymentMethodsParams $queryParams = null,
+        ?RequestOptions $requestOptions = null
+    ): CheckoutsListAvailablePaymentMethodsResponse {
         $path = sprintf('/v0.1/merchants/%s/payment-methods', rawurlencode((string) $merchantCode));
         if ($queryParams !== null) {
             $queryParamsData = [];
@@ -385,8 +640,8 @@ class Checkouts implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Services\CheckoutsListAvailablePaymentMethodsResponse::class, [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\DetailsError::class],
+        return ResponseDecoder::decodeOrThrow($response, CheckoutsListAvailablePaymentMethodsResponse::class, [
+            '400' => ['type' => 'class', 'class' => DetailsError::class],
         ], 'GET', $path);
     }
 
@@ -394,31 +649,34 @@ class Checkouts implements SumUpService
      * Update a checkout
      *
      * @param string $checkoutId Unique identifier of the checkout resource.
-     * @param \SumUp\Types\CheckoutUpdateRequest|array<string, mixed> $body Required request payload
+     * @param CheckoutUpdateRequest|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Checkout
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Checkout
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function update(string $checkoutId, \SumUp\Types\CheckoutUpdateRequest|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\Checkout
-    {
+    public function update(
+        string $checkoutId,
+        CheckoutUpdateRequest|array $body,
+        ?RequestOptions $requestOptions = null
+    ): Checkout {
         $path = sprintf('/v0.1/checkouts/%s', rawurlencode((string) $checkoutId));
         $payload = [];
         $requestBody = $body;
         if (is_array($requestBody)) {
-            $requestBody = \SumUp\Types\CheckoutUpdateRequest::fromArray($requestBody);
+            $requestBody = CheckoutUpdateRequest::fromArray($requestBody);
         }
         $payload = RequestEncoder::encode($requestBody);
         $headers = RequestHeaders::build($this->accessToken, $requestOptions);
 
         $response = $this->client->send('PATCH', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Checkout::class, [
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+        return ResponseDecoder::decodeOrThrow($response, Checkout::class, [
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Error::class],
         ], 'PATCH', $path);
     }
 }
@@ -6,32 +6,64 @@ namespace SumUp\Customers;
 
 namespace SumUp\Services;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+use SumUp\Exception\ApiException;
+use SumUp\Exception\ConnectionException;
+use SumUp\Exception\SDKException;
+use SumUp\Exception\UnexpectedApiException;
+use SumUp\ExplicitFieldsInterface;
 use SumUp\HttpClient\HttpClientInterface;
 use SumUp\HttpClient\RequestHeaders;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\RequestEncoder;
 use SumUp\ResponseDecoder;
+use SumUp\Types\Customer;
+use SumUp\Types\Error;
+use SumUp\Types\ErrorForbidden;
+use SumUp\Types\PaymentInstrumentResponse;
+use SumUp\Types\PersonalDetails;
+use SumUp\Types\Problem;
 
-class CustomersUpdateRequest
+class CustomersUpdateRequest implements AdditionalPropertiesInterface, ExplicitFieldsInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Personal details for the customer.
      *
-     * @var \SumUp\Types\PersonalDetails|null
+     * @var PersonalDetails|null
+     */
+    public ?PersonalDetails $personalDetails = null;
+
+    /**
+     * Properties explicitly set through fromArray(), setters, or the constructor with a non-null value.
+     *
+     * @var array<string, true>
      */
-    public ?\SumUp\Types\PersonalDetails $personalDetails = null;
+    private array $explicitFields = [];
 
     /**
      * Create request DTO.
      *
-     * @param \SumUp\Types\PersonalDetails|null $personalDetails
+     * Null arguments are left out of the payload. Send an explicit null
+     * through a setter or fromArray() to clear a field.
+     *
+     * @param PersonalDetails|null $personalDetails
      */
-    public function __construct(
-        ?\SumUp\Types\PersonalDetails $personalDetails = null
-    ) {
-        \SumUp\Hydrator::hydrate([
+    public function __construct(?PersonalDetails $personalDetails = null)
+    {
+        $this->fill([
             'personal_details' => $personalDetails,
-        ], self::class, $this);
+        ]);
+
+        foreach ([
+            'personalDetails' => $personalDetails,
+        ] as $propertyName => $value) {
+            if ($value !== null) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
     }
 
     /**
@@ -41,12 +73,90 @@ class CustomersUpdateRequest
      */
     public static function fromArray(array $data): self
     {
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self();
+        $request->fill($data);
+        $request->markExplicitFields($data);
 
         return $request;
     }
 
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'personal_details':
+                case 'personalDetails':
+                    $this->personalDetails = $value instanceof PersonalDetails || $value === null ? $value : PersonalDetails::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->personalDetails)) {
+            $data['personal_details'] = $this->personalDetails->toArray();
+        } elseif (isset($this->explicitFields['personalDetails'])) {
+            $data['personal_details'] = null;
+        }
+
+        return $data + $this->additionalProperties();
+    }
+
+    /**
+     * Set personalDetails, sending an explicit null when the value is null.
+     *
+     * @param PersonalDetails|null $personalDetails
+     */
+    public function setPersonalDetails(?PersonalDetails $personalDetails): self
+    {
+        $this->fill(['personal_details' => $personalDetails]);
+        $this->explicitFields['personalDetails'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Names of the properties explicitly set, including those set to null.
+     *
+     * @return string[]
+     */
+    public function explicitFields(): array
+    {
+        return array_keys($this->explicitFields);
+    }
+
+    /**
+     * @param array<string, mixed> $data
+     */
+    private function markExplicitFields(array $data): void
+    {
+        foreach ([
+            'personal_details' => 'personalDetails',
+        ] as $serializedName => $propertyName) {
+            if (array_key_exists($serializedName, $data) || array_key_exists($propertyName, $data)) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
+    }
 }
 
 /**
@@ -91,22 +201,22 @@ class Customers implements SumUpService
     /**
      * Create a customer
      *
-     * @param \SumUp\Types\Customer|array<string, mixed> $body Required request payload
+     * @param Customer|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Customer
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Customer
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function create(\SumUp\Types\Customer|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\Customer
+    public function create(Customer|array $body, ?RequestOptions $requestOptions = null): Customer
     {
         $path = '/v0.1/customers';
         $payload = [];
         $requestBody = $body;
         if (is_array($requestBody)) {
-            $requestBody = \SumUp\Types\Customer::fromArray($requestBody);
+            $requestBody = Customer::fromArray($requestBody);
         }
         $payload = RequestEncoder::encode($requestBody);
         $headers = RequestHeaders::build($this->accessToken, $requestOptions);
@@ -114,12 +224,12 @@ class Customers implements SumUpService
         $response = $this->client->send('POST', $path, $payload, $headers, $requestOptions);
 
         return ResponseDecoder::decodeOrThrow($response, [
-            '201' => ['type' => 'class', 'class' => \SumUp\Types\Customer::class],
+            '201' => ['type' => 'class', 'class' => Customer::class],
         ], [
             '400' => ['type' => 'mixed'],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '403' => ['type' => 'class', 'class' => \SumUp\Types\ErrorForbidden::class],
-            '409' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '403' => ['type' => 'class', 'class' => ErrorForbidden::class],
+            '409' => ['type' => 'class', 'class' => Error::class],
         ], 'POST', $path);
     }
 
@@ -131,13 +241,16 @@ class Customers implements SumUpService
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
      * @return null
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function deactivatePaymentInstrument(string $customerId, string $token, ?RequestOptions $requestOptions = null): null
-    {
+    public function deactivatePaymentInstrument(
+        string $customerId,
+        string $token,
+        ?RequestOptions $requestOptions = null
+    ): null {
         $path = sprintf('/v0.1/customers/%s/payment-instruments/%s', rawurlencode((string) $customerId), rawurlencode((string) $token));
         $payload = [];
         $headers = RequestHeaders::build($this->accessToken, $requestOptions);
@@ -147,10 +260,10 @@ class Customers implements SumUpService
         return ResponseDecoder::decodeOrThrow($response, [
             '204' => ['type' => 'void'],
         ], [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '403' => ['type' => 'class', 'class' => \SumUp\Types\ErrorForbidden::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+            '400' => ['type' => 'class', 'class' => Error::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '403' => ['type' => 'class', 'class' => ErrorForbidden::class],
+            '404' => ['type' => 'class', 'class' => Error::class],
         ], 'DELETE', $path);
     }
 
@@ -160,13 +273,13 @@ class Customers implements SumUpService
      * @param string $customerId Unique identifier of the saved customer resource.
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Customer
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Customer
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function get(string $customerId, ?RequestOptions $requestOptions = null): \SumUp\Types\Customer
+    public function get(string $customerId, ?RequestOptions $requestOptions = null): Customer
     {
         $path = sprintf('/v0.1/customers/%s', rawurlencode((string) $customerId));
         $payload = [];
@@ -174,10 +287,10 @@ class Customers implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Customer::class, [
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '403' => ['type' => 'class', 'class' => \SumUp\Types\ErrorForbidden::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+        return ResponseDecoder::decodeOrThrow($response, Customer::class, [
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '403' => ['type' => 'class', 'class' => ErrorForbidden::class],
+            '404' => ['type' => 'class', 'class' => Error::class],
         ], 'GET', $path);
     }
 
@@ -187,11 +300,11 @@ class Customers implements SumUpService
      * @param string $customerId Unique identifier of the saved customer resource.
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\PaymentInstrumentResponse[]
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return PaymentInstrumentResponse[]
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
     public function listPaymentInstruments(string $customerId, ?RequestOptions $requestOptions = null): array
     {
@@ -202,11 +315,11 @@ class Customers implements SumUpService
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
         return ResponseDecoder::decodeOrThrow($response, [
-            '200' => ['type' => 'array', 'items' => ['type' => 'class', 'class' => \SumUp\Types\PaymentInstrumentResponse::class]],
+            '200' => ['type' => 'array', 'items' => ['type' => 'class', 'class' => PaymentInstrumentResponse::class]],
         ], [
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '403' => ['type' => 'class', 'class' => \SumUp\Types\ErrorForbidden::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '403' => ['type' => 'class', 'class' => ErrorForbidden::class],
+            '404' => ['type' => 'class', 'class' => Error::class],
         ], 'GET', $path);
     }
 
@@ -217,14 +330,17 @@ class Customers implements SumUpService
      * @param CustomersUpdateRequest|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Customer
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Customer
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function update(string $customerId, CustomersUpdateRequest|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\Customer
-    {
+    public function update(
+        string $customerId,
+        CustomersUpdateRequest|array $body,
+        ?RequestOptions $requestOptions = null
+    ): Customer {
         $path = sprintf('/v0.1/customers/%s', rawurlencode((string) $customerId));
         $payload = [];
         $requestBody = $body;
@@ -236,10 +352,10 @@ class Customers implements SumUpService
 
         $response = $this->client->send('PUT', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Customer::class, [
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '403' => ['type' => 'class', 'class' => \SumUp\Types\ErrorForbidden::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+        return ResponseDecoder::decodeOrThrow($response, Customer::class, [
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '403' => ['type' => 'class', 'class' => ErrorForbidden::class],
+            '404' => ['type' => 'class', 'class' => Error::class],
         ], 'PUT', $path);
     }
 }
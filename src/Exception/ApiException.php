@@ -17,10 +17,13 @@ class ApiException extends SDKException
      */
     protected ?string $path;
 
+    /**
+     * @param mixed $responseBody
+     */
     public function __construct(
         string $message = '',
         int $statusCode = 0,
-        mixed $responseBody = null,
+        $responseBody = null,
         ?string $httpMethod = null,
         ?string $path = null,
         ?\Throwable $previous = null
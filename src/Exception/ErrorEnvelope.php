@@ -11,7 +11,10 @@ class ErrorEnvelope
 
     private string $message;
 
-    private mixed $raw;
+    /**
+     * @var mixed
+     */
+    private $raw;
 
     /**
      * @var array<string, array<int, string>>
@@ -19,9 +22,10 @@ class ErrorEnvelope
     private array $headers;
 
     /**
+     * @param mixed $raw
      * @param array<string, array<int, string>> $headers
      */
-    public function __construct(int $status, string $message, mixed $raw = null, array $headers = [])
+    public function __construct(int $status, string $message, $raw = null, array $headers = [])
     {
         $this->status = $status;
         $this->message = $message;
@@ -39,7 +43,10 @@ class ErrorEnvelope
         return $this->message;
     }
 
-    public function getRaw(): mixed
+    /**
+     * @return mixed
+     */
+    public function getRaw()
     {
         return $this->raw;
     }
@@ -21,7 +21,7 @@ class SDKException extends \Exception
      *
      * @var mixed
      */
-    protected mixed $responseBody;
+    protected $responseBody;
 
     /**
      * @param string $message
@@ -32,7 +32,7 @@ class SDKException extends \Exception
     public function __construct(
         string $message = '',
         int $statusCode = 0,
-        mixed $responseBody = null,
+        $responseBody = null,
         ?\Throwable $previous = null
     ) {
         parent::__construct($message, $statusCode, $previous);
@@ -56,7 +56,7 @@ class SDKException extends \Exception
      *
      * @return mixed
      */
-    public function getResponseBody(): mixed
+    public function getResponseBody()
     {
         return $this->responseBody;
     }
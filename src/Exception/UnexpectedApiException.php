@@ -12,12 +12,13 @@ class UnexpectedApiException extends ApiException
     private ?string $rawResponseBody;
 
     /**
+     * @param mixed $responseBody
      * @param array<string, mixed>|null $headers
      */
     public function __construct(
         string $message = '',
         int $statusCode = 0,
-        mixed $responseBody = null,
+        $responseBody = null,
         ?string $httpMethod = null,
         ?string $path = null,
         ?array $headers = null,
@@ -0,0 +1,21 @@
+<?php
+
+namespace SumUp;
+
+/**
+ * Interface ExplicitFieldsInterface
+ *
+ * Implemented by request DTOs that distinguish explicitly set properties from
+ * untouched ones, so that an explicit `null` can be sent to clear a field.
+ *
+ * @package SumUp
+ */
+interface ExplicitFieldsInterface
+{
+    /**
+     * Names of the properties explicitly set, including those set to null.
+     *
+     * @return string[]
+     */
+    public function explicitFields(): array;
+}
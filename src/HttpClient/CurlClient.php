@@ -98,11 +98,11 @@ class CurlClient implements HttpClientInterface
                 curl_setopt($ch, CURLOPT_CAINFO, $this->caBundlePath);
             }
 
-            if ($options?->timeout !== null) {
+            if ($options !== null && $options->timeout !== null) {
                 curl_setopt($ch, CURLOPT_TIMEOUT, $options->timeout);
             }
 
-            if ($options?->connectTimeout !== null) {
+            if ($options !== null && $options->connectTimeout !== null) {
                 curl_setopt($ch, CURLOPT_CONNECTTIMEOUT, $options->connectTimeout);
             }
 
@@ -100,11 +100,11 @@ class GuzzleClient implements HttpClientInterface
             $requestParams['json'] = $body;
         }
 
-        if ($options?->timeout !== null) {
+        if ($options !== null && $options->timeout !== null) {
             $requestParams['timeout'] = $options->timeout;
         }
 
-        if ($options?->connectTimeout !== null) {
+        if ($options !== null && $options->connectTimeout !== null) {
             $requestParams['connect_timeout'] = $options->connectTimeout;
         }
 
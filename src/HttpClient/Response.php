@@ -21,7 +21,7 @@ class Response
      *
      * @var mixed
      */
-    protected mixed $body;
+    protected $body;
 
     /**
      * Normalized response headers.
@@ -48,7 +48,7 @@ class Response
      */
     public function __construct(
         int $httpResponseCode,
-        mixed $body,
+        $body,
         array $headers = [],
         ?string $rawBody = null
     ) {
@@ -73,7 +73,7 @@ class Response
      *
      * @return array|mixed
      */
-    public function getBody(): mixed
+    public function getBody()
     {
         return $this->body;
     }
@@ -8,6 +8,10 @@ use ReflectionProperty;
 
 /**
  * Hydrates SDK models from associative arrays or stdClass payloads.
+ *
+ * Classes providing a static fromArray() method hydrate themselves; other
+ * classes, and payloads fromArray() rejects for a missing required field,
+ * are hydrated through reflection and their `@var` docblocks.
  */
 class Hydrator
 {
@@ -41,14 +45,31 @@ class Hydrator
             return $payload;
         }
 
+        // Generated classes bake their field mapping into fromArray(). The
+        // request DTOs reject a payload missing a required field, which a
+        // response is hydrated from leniently through reflection instead.
+        if ($target === null && method_exists($className, 'fromArray')) {
+            try {
+                return $className::fromArray($payload);
+            } catch (\InvalidArgumentException $e) {
+                // Hydrated below, leaving the missing fields uninitialized.
+            }
+        }
+
+        if (is_subclass_of($className, \ArrayObject::class)) {
+            return self::hydrateMap($payload, $className);
+        }
+
         $object = ($target instanceof $className)
             ? $target
             : (new ReflectionClass($className))->newInstanceWithoutConstructor();
         $properties = self::getClassProperties($className);
+        $additionalProperties = [];
 
         foreach ($payload as $key => $value) {
             $propertyName = self::normalizePropertyName($key);
             if (!isset($properties[$propertyName])) {
+                $additionalProperties[$key] = $value;
                 continue;
             }
 
@@ -56,9 +77,40 @@ class Hydrator
             $property->setValue($object, self::castValue($value, $property));
         }
 
+        if (
+            $object instanceof AdditionalPropertiesInterface
+            && method_exists($object, 'setAdditionalProperties')
+            && $additionalProperties !== []
+        ) {
+            $object->setAdditionalProperties(array_merge($object->additionalProperties(), $additionalProperties));
+        }
+
         return $object;
     }
 
+    /**
+     * Hydrate a generated map class, casting each value into its VALUE_TYPE.
+     *
+     * @param array<int|string, mixed> $payload
+     * @param class-string<\ArrayObject<int|string, mixed>> $className
+     *
+     * @return \ArrayObject<int|string, mixed>
+     */
+    private static function hydrateMap(array $payload, $className)
+    {
+        $valueType = 'mixed';
+        if (defined($className . '::VALUE_TYPE')) {
+            $valueType = (string) constant($className . '::VALUE_TYPE');
+        }
+
+        $values = [];
+        foreach ($payload as $key => $value) {
+            $values[$key] = self::castTypedValue($value, $valueType);
+        }
+
+        return new $className($values);
+    }
+
     /**
      * @param string $className
      *
@@ -120,7 +172,7 @@ class Hydrator
             }
         }
 
-        if (enum_exists($typeName)) {
+        if (function_exists('enum_exists') && enum_exists($typeName)) {
             return self::castEnumValue($value, $typeName);
         }
 
@@ -190,24 +242,63 @@ class Hydrator
             return null;
         }
 
-        if (!preg_match('/@var\s+([^\s]+)/', $docComment, $matches)) {
+        if (!preg_match('/@var\s+([^\r\n]+)/', $docComment, $matches)) {
             return null;
         }
 
-        $types = explode('|', $matches[1]);
-        foreach ($types as $type) {
-            $type = trim($type);
+        foreach (self::splitDocType($matches[1], '|') as $type) {
             if ($type === '' || $type === 'null') {
                 continue;
             }
             if (substr($type, -2) === '[]') {
                 return substr($type, 0, -2);
             }
+            if (strpos($type, 'array<') === 0 && substr($type, -1) === '>') {
+                $parameters = self::splitDocType(substr($type, 6, -1), ',');
+                if (count($parameters) === 2) {
+                    return $parameters[1];
+                }
+            }
         }
 
         return null;
     }
 
+    /**
+     * Split a docblock type on a separator outside of generic brackets,
+     * stopping at the first whitespace that ends the type declaration.
+     *
+     * @param string $declaration
+     * @param string $separator
+     *
+     * @return string[]
+     */
+    private static function splitDocType($declaration, $separator)
+    {
+        $parts = [];
+        $current = '';
+        $depth = 0;
+        $length = strlen($declaration);
+        for ($i = 0; $i < $length; $i++) {
+            $char = $declaration[$i];
+            if ($char === '<') {
+                $depth++;
+            } elseif ($char === '>') {
+                $depth--;
+            } elseif ($depth === 0 && $char === $separator) {
+                $parts[] = trim($current);
+                $current = '';
+                continue;
+            } elseif ($depth === 0 && $separator === '|' && ctype_space($char)) {
+                break;
+            }
+            $current .= $char;
+        }
+        $parts[] = trim($current);
+
+        return $parts;
+    }
+
     /**
      * @param mixed $item
      * @param string $itemType
@@ -217,39 +308,62 @@ class Hydrator
      */
     private static function castArrayItem($item, $itemType, ReflectionProperty $property)
     {
-        $normalizedType = ltrim($itemType, '\\');
+        $className = $itemType;
+        if ($itemType !== '' && !self::isBuiltinType($itemType) && $itemType[0] !== '\\') {
+            $namespace = $property->getDeclaringClass()->getNamespaceName();
+            if (!empty($namespace)) {
+                $className = $namespace . '\\' . $itemType;
+            }
+        }
+
+        return self::castTypedValue($item, $className);
+    }
+
+    /**
+     * Cast a value into a builtin type or a fully qualified class name.
+     *
+     * @param mixed $value
+     * @param string $type
+     *
+     * @return mixed
+     */
+    private static function castTypedValue($value, $type)
+    {
+        $normalizedType = ltrim($type, '\\');
         switch ($normalizedType) {
             case 'string':
-                return (string) $item;
+                return (string) $value;
             case 'int':
-                return (int) $item;
+                return (int) $value;
             case 'float':
-                return (float) $item;
+                return (float) $value;
             case 'bool':
-                return (bool) $item;
+                return (bool) $value;
             case 'array':
-                return is_array($item) ? $item : [];
+                return is_array($value) ? $value : [];
             case 'mixed':
-                return $item;
+                return $value;
         }
 
-        $className = $itemType;
-        if ($itemType[0] !== '\\') {
-            $namespace = $property->getDeclaringClass()->getNamespaceName();
-            if (!empty($namespace)) {
-                $className = $namespace . '\\' . $itemType;
-            } else {
-                $className = $itemType;
-            }
-        } else {
-            $className = ltrim($itemType, '\\');
+        if (function_exists('enum_exists') && enum_exists($normalizedType)) {
+            return self::castEnumValue($value, $normalizedType);
         }
 
-        if (!class_exists($className)) {
-            return $item;
+        if (!class_exists($normalizedType)) {
+            return $value;
         }
 
-        return self::hydrate($item, $className);
+        return self::hydrate($value, $normalizedType);
+    }
+
+    /**
+     * @param string $type
+     *
+     * @return bool
+     */
+    private static function isBuiltinType($type)
+    {
+        return in_array(ltrim($type, '\\'), ['string', 'int', 'float', 'bool', 'array', 'mixed'], true);
     }
 
     /**
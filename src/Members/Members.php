@@ -6,14 +6,25 @@ namespace SumUp\Members;
 
 namespace SumUp\Services;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+use SumUp\Exception\ApiException;
+use SumUp\Exception\ConnectionException;
+use SumUp\Exception\SDKException;
+use SumUp\Exception\UnexpectedApiException;
+use SumUp\ExplicitFieldsInterface;
 use SumUp\HttpClient\HttpClientInterface;
 use SumUp\HttpClient\RequestHeaders;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\RequestEncoder;
 use SumUp\ResponseDecoder;
+use SumUp\Types\Member;
+use SumUp\Types\Problem;
 
-class MembersCreateRequest
+class MembersCreateRequest implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * True if the user is managed by the merchant. In this case, we'll created a virtual user with the provided password and nickname.
      *
@@ -83,7 +94,7 @@ class MembersCreateRequest
         ?array $metadata = null,
         ?array $attributes = null
     ) {
-        \SumUp\Hydrator::hydrate([
+        $this->fill([
             'email' => $email,
             'roles' => $roles,
             'is_managed_user' => $isManagedUser,
@@ -91,7 +102,7 @@ class MembersCreateRequest
             'nickname' => $nickname,
             'metadata' => $metadata,
             'attributes' => $attributes,
-        ], self::class, $this);
+        ]);
     }
 
     /**
@@ -101,13 +112,16 @@ class MembersCreateRequest
      */
     public static function fromArray(array $data): self
     {
-        self::assertRequiredFields($data, [
+        $required = self::requiredFields($data, [
             'email' => 'email',
             'roles' => 'roles',
         ]);
 
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self(
+            (string) $required['email'],
+            array_map(static fn ($item) => (string) $item, (array) $required['roles'])
+        );
+        $request->fill($data);
 
         return $request;
     }
@@ -115,22 +129,106 @@ class MembersCreateRequest
     /**
      * @param array<string, mixed> $data
      * @param array<string, string> $requiredFields
+     *
+     * @return array<string, mixed>
      */
-    private static function assertRequiredFields(array $data, array $requiredFields): void
+    private static function requiredFields(array $data, array $requiredFields): array
     {
+        $values = [];
         foreach ($requiredFields as $serializedName => $propertyName) {
-            if (!array_key_exists($serializedName, $data) && !array_key_exists($propertyName, $data)) {
+            if (array_key_exists($serializedName, $data)) {
+                $values[$propertyName] = $data[$serializedName];
+            } elseif (array_key_exists($propertyName, $data)) {
+                $values[$propertyName] = $data[$propertyName];
+            } else {
                 throw new \InvalidArgumentException(sprintf('Missing required field "%s".', $serializedName));
             }
         }
+
+        return $values;
     }
 
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'is_managed_user':
+                case 'isManagedUser':
+                    $this->isManagedUser = $value === null ? null : (bool) $value;
+                    break;
+                case 'email':
+                    $this->email = (string) $value;
+                    break;
+                case 'password':
+                    $this->password = $value === null ? null : (string) $value;
+                    break;
+                case 'nickname':
+                    $this->nickname = $value === null ? null : (string) $value;
+                    break;
+                case 'roles':
+                    $this->roles = array_map(static fn ($item) => (string) $item, (array) $value);
+                    break;
+                case 'metadata':
+                    $this->metadata = $value === null ? null : (array) $value;
+                    break;
+                case 'attributes':
+                    $this->attributes = $value === null ? null : (array) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->isManagedUser)) {
+            $data['is_managed_user'] = $this->isManagedUser;
+        }
+        if (isset($this->email)) {
+            $data['email'] = $this->email;
+        }
+        if (isset($this->password)) {
+            $data['password'] = $this->password;
+        }
+        if (isset($this->nickname)) {
+            $data['nickname'] = $this->nickname;
+        }
+        if (isset($this->roles)) {
+            $data['roles'] = $this->roles;
+        }
+        if (isset($this->metadata)) {
+            $data['metadata'] = $this->metadata;
+        }
+        if (isset($this->attributes)) {
+            $data['attributes'] = $this->attributes;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
-class MembersUpdateRequest
+class MembersUpdateRequest implements AdditionalPropertiesInterface, ExplicitFieldsInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
-     *
      * @var string[]|null
      */
     public ?array $roles = null;
@@ -156,9 +254,19 @@ class MembersUpdateRequest
      */
     public ?MembersUpdateRequestUser $user = null;
 
+    /**
+     * Properties explicitly set through fromArray(), setters, or the constructor with a non-null value.
+     *
+     * @var array<string, true>
+     */
+    private array $explicitFields = [];
+
     /**
      * Create request DTO.
      *
+     * Null arguments are left out of the payload. Send an explicit null
+     * through a setter or fromArray() to clear a field.
+     *
      * @param string[]|null $roles
      * @param array<string, mixed>|null $metadata
      * @param array<string, mixed>|null $attributes
@@ -170,12 +278,23 @@ class MembersUpdateRequest
         ?array $attributes = null,
         ?MembersUpdateRequestUser $user = null
     ) {
-        \SumUp\Hydrator::hydrate([
+        $this->fill([
             'roles' => $roles,
             'metadata' => $metadata,
             'attributes' => $attributes,
             'user' => $user,
-        ], self::class, $this);
+        ]);
+
+        foreach ([
+            'roles' => $roles,
+            'metadata' => $metadata,
+            'attributes' => $attributes,
+            'user' => $user,
+        ] as $propertyName => $value) {
+            if ($value !== null) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
     }
 
     /**
@@ -185,35 +304,237 @@ class MembersUpdateRequest
      */
     public static function fromArray(array $data): self
     {
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self();
+        $request->fill($data);
+        $request->markExplicitFields($data);
 
         return $request;
     }
 
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'roles':
+                    $this->roles = $value === null ? null : array_map(static fn ($item) => (string) $item, (array) $value);
+                    break;
+                case 'metadata':
+                    $this->metadata = $value === null ? null : (array) $value;
+                    break;
+                case 'attributes':
+                    $this->attributes = $value === null ? null : (array) $value;
+                    break;
+                case 'user':
+                    $this->user = $value instanceof MembersUpdateRequestUser || $value === null ? $value : MembersUpdateRequestUser::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->roles)) {
+            $data['roles'] = $this->roles;
+        } elseif (isset($this->explicitFields['roles'])) {
+            $data['roles'] = null;
+        }
+        if (isset($this->metadata)) {
+            $data['metadata'] = $this->metadata;
+        } elseif (isset($this->explicitFields['metadata'])) {
+            $data['metadata'] = null;
+        }
+        if (isset($this->attributes)) {
+            $data['attributes'] = $this->attributes;
+        } elseif (isset($this->explicitFields['attributes'])) {
+            $data['attributes'] = null;
+        }
+        if (isset($this->user)) {
+            $data['user'] = $this->user->toArray();
+        } elseif (isset($this->explicitFields['user'])) {
+            $data['user'] = null;
+        }
+
+        return $data + $this->additionalProperties();
+    }
+
+    /**
+     * Set roles, sending an explicit null when the value is null.
+     *
+     * @param string[]|null $roles
+     */
+    public function setRoles(?array $roles): self
+    {
+        $this->fill(['roles' => $roles]);
+        $this->explicitFields['roles'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set metadata, sending an explicit null when the value is null.
+     *
+     * @param array<string, mixed>|null $metadata
+     */
+    public function setMetadata(?array $metadata): self
+    {
+        $this->fill(['metadata' => $metadata]);
+        $this->explicitFields['metadata'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set attributes, sending an explicit null when the value is null.
+     *
+     * @param array<string, mixed>|null $attributes
+     */
+    public function setAttributes(?array $attributes): self
+    {
+        $this->fill(['attributes' => $attributes]);
+        $this->explicitFields['attributes'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set user, sending an explicit null when the value is null.
+     *
+     * @param MembersUpdateRequestUser|null $user
+     */
+    public function setUser(?MembersUpdateRequestUser $user): self
+    {
+        $this->fill(['user' => $user]);
+        $this->explicitFields['user'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Names of the properties explicitly set, including those set to null.
+     *
+     * @return string[]
+     */
+    public function explicitFields(): array
+    {
+        return array_keys($this->explicitFields);
+    }
+
+    /**
+     * @param array<string, mixed> $data
+     */
+    private function markExplicitFields(array $data): void
+    {
+        foreach ([
+            'roles' => 'roles',
+            'metadata' => 'metadata',
+            'attributes' => 'attributes',
+            'user' => 'user',
+        ] as $serializedName => $propertyName) {
+            if (array_key_exists($serializedName, $data) || array_key_exists($propertyName, $data)) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
+    }
 }
 
-class MembersListResponse
+class MembersListResponse implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
-     *
-     * @var \SumUp\Types\Member[]
+     * @var Member[]
      */
     public array $items;
 
     /**
-     *
      * @var int|null
      */
     public ?int $totalCount = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'items':
+                    $this->items = array_map(static fn ($item) => $item instanceof Member ? $item : Member::fromArray($item), (array) $value);
+                    break;
+                case 'total_count':
+                case 'totalCount':
+                    $this->totalCount = $value === null ? null : (int) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->items)) {
+            $data['items'] = array_map(static fn (Member $item) => $item->toArray(), $this->items);
+        }
+        if (isset($this->totalCount)) {
+            $data['total_count'] = $this->totalCount;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
 /**
  * Allows you to update user data of managed users.
  */
-class MembersUpdateRequestUser
+class MembersUpdateRequestUser implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * User's nickname. Used for display purposes only.
      *
@@ -228,6 +549,62 @@ class MembersUpdateRequestUser
      */
     public ?string $password = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'nickname':
+                    $this->nickname = $value === null ? null : (string) $value;
+                    break;
+                case 'password':
+                    $this->password = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->nickname)) {
+            $data['nickname'] = $this->nickname;
+        }
+        if (isset($this->password)) {
+            $data['password'] = $this->password;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
 /**
@@ -242,21 +619,21 @@ class MembersListParams
      *
      * @var int|null
      */
-    public ?int $offset = null;
+    public ?int $offset = 0;
 
     /**
      * Maximum number of members to return.
      *
      * @var int|null
      */
-    public ?int $limit = null;
+    public ?int $limit = 10;
 
     /**
      * Indicates to skip count query.
      *
      * @var bool|null
      */
-    public ?bool $scroll = null;
+    public ?bool $scroll = false;
 
     /**
      * Filter the returned members by email address prefix.
@@ -285,7 +662,6 @@ class MembersListParams
      * @var string[]|null
      */
     public ?array $roles = null;
-
 }
 
 /**
@@ -330,14 +706,19 @@ class Members implements SumUpService
      * @param MembersCreateRequest|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Member
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Member
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
-    public function create(string $merchantCode, MembersCreateRequest|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\Member
-    {
+    public function create(
+        string $merchantCode,
+        MembersCreateRequest|array $body,
+        ?RequestOptions $requestOptions = null
+    ): Member {
         $path = sprintf('/v0.1/merchants/%s/members', rawurlencode((string) $merchantCode));
         $payload = [];
         $requestBody = $body;
@@ -350,11 +731,11 @@ class Members implements SumUpService
         $response = $this->client->send('POST', $path, $payload, $headers, $requestOptions);
 
         return ResponseDecoder::decodeOrThrow($response, [
-            '201' => ['type' => 'class', 'class' => \SumUp\Types\Member::class],
+            '201' => ['type' => 'class', 'class' => Member::class],
         ], [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '429' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
+            '429' => ['type' => 'class', 'class' => Problem::class],
         ], 'POST', $path);
     }
 
@@ -366,10 +747,12 @@ class Members implements SumUpService
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
      * @return null
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
     public function delete(string $merchantCode, string $memberId, ?RequestOptions $requestOptions = null): null
     {
@@ -382,8 +765,8 @@ class Members implements SumUpService
         return ResponseDecoder::decodeOrThrow($response, [
             '200' => ['type' => 'void'],
         ], [
-            '403' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '403' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'DELETE', $path);
     }
 
@@ -394,13 +777,15 @@ class Members implements SumUpService
      * @param string $memberId The ID of the member to retrieve.
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Member
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Member
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
-    public function get(string $merchantCode, string $memberId, ?RequestOptions $requestOptions = null): \SumUp\Types\Member
+    public function get(string $merchantCode, string $memberId, ?RequestOptions $requestOptions = null): Member
     {
         $path = sprintf('/v0.1/merchants/%s/members/%s', rawurlencode((string) $merchantCode), rawurlencode((string) $memberId));
         $payload = [];
@@ -408,8 +793,8 @@ class Members implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Member::class, [
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, Member::class, [
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -420,24 +805,29 @@ class Members implements SumUpService
      * @param MembersListParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Services\MembersListResponse
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return MembersListResponse
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
-    public function list(string $merchantCode, ?MembersListParams $queryParams = null, ?RequestOptions $requestOptions = null): \SumUp\Services\MembersListResponse
-    {
+    public function list(
+        string $merchantCode,
+        ?MembersListParams $queryParams = null,
+        ?RequestOptions $requestOptions = null
+    ): MembersListResponse {
         $path = sprintf('/v0.1/merchants/%s/members', rawurlencode((string) $merchantCode));
         if ($queryParams !== null) {
             $queryParamsData = [];
-            if (isset($queryParams->offset)) {
+            if (isset($queryParams->offset) && $queryParams->offset !== 0) {
                 $queryParamsData['offset'] = $queryParams->offset;
             }
-            if (isset($queryParams->limit)) {
+            if (isset($queryParams->limit) && $queryParams->limit !== 10) {
                 $queryParamsData['limit'] = $queryParams->limit;
             }
-            if (isset($queryParams->scroll)) {
+            if (isset($queryParams->scroll) && $queryParams->scroll !== false) {
                 $queryParamsData['scroll'] = $queryParams->scroll;
             }
             if (isset($queryParams->email)) {
@@ -464,8 +854,8 @@ class Members implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Services\MembersListResponse::class, [
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, MembersListResponse::class, [
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -477,14 +867,20 @@ class Members implements SumUpService
      * @param MembersUpdateRequest|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Member
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Member
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
-    public function update(string $merchantCode, string $memberId, MembersUpdateRequest|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\Member
-    {
+    public function update(
+        string $merchantCode,
+        string $memberId,
+        MembersUpdateRequest|array $body,
+        ?RequestOptions $requestOptions = null
+    ): Member {
         $path = sprintf('/v0.1/merchants/%s/members/%s', rawurlencode((string) $merchantCode), rawurlencode((string) $memberId));
         $payload = [];
         $requestBody = $body;
@@ -496,11 +892,11 @@ class Members implements SumUpService
 
         $response = $this->client->send('PUT', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Member::class, [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '403' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '409' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, Member::class, [
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '403' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
+            '409' => ['type' => 'class', 'class' => Problem::class],
         ], 'PUT', $path);
     }
 }
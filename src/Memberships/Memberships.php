@@ -6,25 +6,90 @@ namespace SumUp\Memberships;
 
 namespace SumUp\Services;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+use SumUp\Exception\ApiException;
+use SumUp\Exception\ConnectionException;
+use SumUp\Exception\SDKException;
+use SumUp\Exception\UnexpectedApiException;
 use SumUp\HttpClient\HttpClientInterface;
 use SumUp\HttpClient\RequestHeaders;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\ResponseDecoder;
+use SumUp\Types\Membership;
+use SumUp\Types\Problem;
 
-class MembershipsListResponse
+class MembershipsListResponse implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
-     *
-     * @var \SumUp\Types\Membership[]
+     * @var Membership[]
      */
     public array $items;
 
     /**
-     *
      * @var int
      */
     public int $totalCount;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'items':
+                    $this->items = array_map(static fn ($item) => $item instanceof Membership ? $item : Membership::fromArray($item), (array) $value);
+                    break;
+                case 'total_count':
+                case 'totalCount':
+                    $this->totalCount = (int) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->items)) {
+            $data['items'] = array_map(static fn (Membership $item) => $item->toArray(), $this->items);
+        }
+        if (isset($this->totalCount)) {
+            $data['total_count'] = $this->totalCount;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
 /**
@@ -39,14 +104,14 @@ class MembershipsListParams
      *
      * @var int|null
      */
-    public ?int $offset = null;
+    public ?int $offset = 0;
 
     /**
      * Maximum number of members to return.
      *
      * @var int|null
      */
-    public ?int $limit = null;
+    public ?int $limit = 10;
 
     /**
      * Filter memberships by resource kind.
@@ -95,9 +160,9 @@ class MembershipsListParams
      * Filter memberships by the parent of the resource the membership is in.
      * When filtering by parent both `resource.parent.id` and `resource.parent.type` must be present. Pass explicit null to filter for resources without a parent.
      *
-     * @var mixed|null
+     * @var string|null
      */
-    public mixed $resourceParentType = null;
+    public ?string $resourceParentType = null;
 
     /**
      * Filter the returned memberships by role.
@@ -105,7 +170,6 @@ class MembershipsListParams
      * @var string[]|null
      */
     public ?array $roles = null;
-
 }
 
 /**
@@ -149,21 +213,25 @@ class Memberships implements SumUpService
      * @param MembershipsListParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Services\MembershipsListResponse
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return MembershipsListResponse
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
-    public function list(?MembershipsListParams $queryParams = null, ?RequestOptions $requestOptions = null): \SumUp\Services\MembershipsListResponse
-    {
+    public function list(
+        ?MembershipsListParams $queryParams = null,
+        ?RequestOptions $requestOptions = null
+    ): MembershipsListResponse {
         $path = '/v0.1/memberships';
         if ($queryParams !== null) {
             $queryParamsData = [];
-            if (isset($queryParams->offset)) {
+            if (isset($queryParams->offset) && $queryParams->offset !== 0) {
                 $queryParamsData['offset'] = $queryParams->offset;
             }
-            if (isset($queryParams->limit)) {
+            if (isset($queryParams->limit) && $queryParams->limit !== 10) {
                 $queryParamsData['limit'] = $queryParams->limit;
             }
             if (isset($queryParams->kind)) {
@@ -202,9 +270,9 @@ class Memberships implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Services\MembershipsListResponse::class, [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, MembershipsListResponse::class, [
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 }
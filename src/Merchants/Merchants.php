@@ -6,10 +6,18 @@ namespace SumUp\Merchants;
 
 namespace SumUp\Services;
 
+use SumUp\Exception\ApiException;
+use SumUp\Exception\ConnectionException;
+use SumUp\Exception\SDKException;
+use SumUp\Exception\UnexpectedApiException;
 use SumUp\HttpClient\HttpClientInterface;
 use SumUp\HttpClient\RequestHeaders;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\ResponseDecoder;
+use SumUp\Types\ListPersonsResponseBody;
+use SumUp\Types\Merchant;
+use SumUp\Types\Person;
+use SumUp\Types\Problem;
 
 /**
  * Query parameters for MerchantsGetParams.
@@ -24,7 +32,6 @@ class MerchantsGetParams
      * @var string|null
      */
     public ?string $version = null;
-
 }
 
 /**
@@ -40,7 +47,6 @@ class MerchantsGetPersonParams
      * @var string|null
      */
     public ?string $version = null;
-
 }
 
 /**
@@ -56,7 +62,6 @@ class MerchantsListPersonsParams
      * @var string|null
      */
     public ?string $version = null;
-
 }
 
 /**
@@ -101,14 +106,17 @@ class Merchants implements SumUpService
      * @param MerchantsGetParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Merchant
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Merchant
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function get(string $merchantCode, ?MerchantsGetParams $queryParams = null, ?RequestOptions $requestOptions = null): \SumUp\Types\Merchant
-    {
+    public function get(
+        string $merchantCode,
+        ?MerchantsGetParams $queryParams = null,
+        ?RequestOptions $requestOptions = null
+    ): Merchant {
         $path = sprintf('/v1/merchants/%s', rawurlencode((string) $merchantCode));
         if ($queryParams !== null) {
             $queryParamsData = [];
@@ -127,8 +135,8 @@ class Merchants implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Merchant::class, [
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, Merchant::class, [
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -140,14 +148,18 @@ class Merchants implements SumUpService
      * @param MerchantsGetPersonParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Person
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Person
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function getPerson(string $merchantCode, string $personId, ?MerchantsGetPersonParams $queryParams = null, ?RequestOptions $requestOptions = null): \SumUp\Types\Person
-    {
+    public function getPerson(
+        string $merchantCode,
+        string $personId,
+        ?MerchantsGetPersonParams $queryParams = null,
+        ?RequestOptions $requestOptions = null
+    ): Person {
         $path = sprintf('/v1/merchants/%s/persons/%s', rawurlencode((string) $merchantCode), rawurlencode((string) $personId));
         if ($queryParams !== null) {
             $queryParamsData = [];
@@ -166,8 +178,8 @@ class Merchants implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Person::class, [
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, Person::class, [
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -178,14 +190,17 @@ class Merchants implements SumUpService
      * @param MerchantsListPersonsParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\ListPersonsResponseBody
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return ListPersonsResponseBody
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function listPersons(string $merchantCode, ?MerchantsListPersonsParams $queryParams = null, ?RequestOptions $requestOptions = null): \SumUp\Types\ListPersonsResponseBody
-    {
+    public function listPersons(
+        string $merchantCode,
+        ?MerchantsListPersonsParams $queryParams = null,
+        ?RequestOptions $requestOptions = null
+    ): ListPersonsResponseBody {
         $path = sprintf('/v1/merchants/%s/persons', rawurlencode((string) $merchantCode));
         if ($queryParams !== null) {
             $queryParamsData = [];
@@ -204,8 +219,8 @@ class Merchants implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\ListPersonsResponseBody::class, [
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, ListPersonsResponseBody::class, [
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 }
@@ -6,10 +6,17 @@ namespace SumUp\Payouts;
 
 namespace SumUp\Services;
 
+use SumUp\Exception\ApiException;
+use SumUp\Exception\ConnectionException;
+use SumUp\Exception\SDKException;
+use SumUp\Exception\UnexpectedApiException;
 use SumUp\HttpClient\HttpClientInterface;
 use SumUp\HttpClient\RequestHeaders;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\ResponseDecoder;
+use SumUp\Types\ErrorExtended;
+use SumUp\Types\FinancialPayout;
+use SumUp\Types\Problem;
 
 /**
  * Query parameters for PayoutsListParams.
@@ -37,7 +44,7 @@ class PayoutsListParams
      *
      * @var string|null
      */
-    public ?string $format = null;
+    public ?string $format = 'json';
 
     /**
      * Maximum number of payout records to return.
@@ -51,8 +58,7 @@ class PayoutsListParams
      *
      * @var string|null
      */
-    public ?string $order = null;
-
+    public ?string $order = 'asc';
 }
 
 /**
@@ -99,14 +105,17 @@ class Payouts implements SumUpService
      * @param PayoutsListParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\FinancialPayout[]
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return FinancialPayout[]
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function list(string $merchantCode, ?PayoutsListParams $queryParams = null, ?RequestOptions $requestOptions = null): array
-    {
+    public function list(
+        string $merchantCode,
+        ?PayoutsListParams $queryParams = null,
+        ?RequestOptions $requestOptions = null
+    ): array {
         $path = sprintf('/v1.0/merchants/%s/payouts', rawurlencode((string) $merchantCode));
         if ($queryParams !== null) {
             $queryParamsData = [];
@@ -116,13 +125,13 @@ class Payouts implements SumUpService
             if (isset($queryParams->endDate)) {
                 $queryParamsData['end_date'] = $queryParams->endDate;
             }
-            if (isset($queryParams->format)) {
+            if (isset($queryParams->format) && $queryParams->format !== 'json') {
                 $queryParamsData['format'] = $queryParams->format;
             }
             if (isset($queryParams->limit)) {
                 $queryParamsData['limit'] = $queryParams->limit;
             }
-            if (isset($queryParams->order)) {
+            if (isset($queryParams->order) && $queryParams->order !== 'asc') {
                 $queryParamsData['order'] = $queryParams->order;
             }
             if (!empty($queryParamsData)) {
@@ -138,10 +147,10 @@ class Payouts implements SumUpService
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
         return ResponseDecoder::decodeOrThrow($response, [
-            '200' => ['type' => 'array', 'items' => ['type' => 'class', 'class' => \SumUp\Types\FinancialPayout::class]],
+            '200' => ['type' => 'array', 'items' => ['type' => 'class', 'class' => FinancialPayout::class]],
         ], [
-            '400' => ['type' => 'array', 'items' => ['type' => 'class', 'class' => \SumUp\Types\ErrorExtended::class]],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '400' => ['type' => 'array', 'items' => ['type' => 'class', 'class' => ErrorExtended::class]],
+            '401' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 }
@@ -6,14 +6,31 @@ namespace SumUp\Readers;
 
 namespace SumUp\Services;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+use SumUp\Exception\ApiException;
+use SumUp\Exception\ConnectionException;
+use SumUp\Exception\SDKException;
+use SumUp\Exception\UnexpectedApiException;
+use SumUp\ExplicitFieldsInterface;
 use SumUp\HttpClient\HttpClientInterface;
 use SumUp\HttpClient\RequestHeaders;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\RequestEncoder;
 use SumUp\ResponseDecoder;
-
-class ReadersCreateRequest
+use SumUp\Types\CreateReaderCheckoutRequest;
+use SumUp\Types\CreateReaderCheckoutResponse;
+use SumUp\Types\GetReaderCheckoutResponse;
+use SumUp\Types\Problem;
+use SumUp\Types\Reader;
+use SumUp\Types\ReaderPaymentRequestParams;
+use SumUp\Types\ReaderPaymentResponse;
+use SumUp\Types\StatusResponse;
+
+class ReadersCreateRequest implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * The pairing code is a 8 or 9 character alphanumeric string that is displayed on a SumUp Device after initiating the pairing. It is used to link the physical device to the created pairing.
      *
@@ -42,16 +59,13 @@ class ReadersCreateRequest
      * @param string $name
      * @param array<string, mixed>|null $metadata
      */
-    public function __construct(
-        string $pairingCode,
-        string $name,
-        ?array $metadata = null
-    ) {
-        \SumUp\Hydrator::hydrate([
+    public function __construct(string $pairingCode, string $name, ?array $metadata = null)
+    {
+        $this->fill([
             'pairing_code' => $pairingCode,
             'name' => $name,
             'metadata' => $metadata,
-        ], self::class, $this);
+        ]);
     }
 
     /**
@@ -61,13 +75,16 @@ class ReadersCreateRequest
      */
     public static function fromArray(array $data): self
     {
-        self::assertRequiredFields($data, [
+        $required = self::requiredFields($data, [
             'pairing_code' => 'pairingCode',
             'name' => 'name',
         ]);
 
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self(
+            (string) $required['pairingCode'],
+            (string) $required['name']
+        );
+        $request->fill($data);
 
         return $request;
     }
@@ -75,16 +92,75 @@ class ReadersCreateRequest
     /**
      * @param array<string, mixed> $data
      * @param array<string, string> $requiredFields
+     *
+     * @return array<string, mixed>
      */
-    private static function assertRequiredFields(array $data, array $requiredFields): void
+    private static function requiredFields(array $data, array $requiredFields): array
     {
+        $values = [];
         foreach ($requiredFields as $serializedName => $propertyName) {
-            if (!array_key_exists($serializedName, $data) && !array_key_exists($propertyName, $data)) {
+            if (array_key_exists($serializedName, $data)) {
+                $values[$propertyName] = $data[$serializedName];
+            } elseif (array_key_exists($propertyName, $data)) {
+                $values[$propertyName] = $data[$propertyName];
+            } else {
                 throw new \InvalidArgumentException(sprintf('Missing required field "%s".', $serializedName));
             }
         }
+
+        return $values;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'pairing_code':
+                case 'pairingCode':
+                    $this->pairingCode = (string) $value;
+                    break;
+                case 'name':
+                    $this->name = (string) $value;
+                    break;
+                case 'metadata':
+                    $this->metadata = $value === null ? null : (array) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
     }
 
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->pairingCode)) {
+            $data['pairing_code'] = $this->pairingCode;
+        }
+        if (isset($this->name)) {
+            $data['name'] = $this->name;
+        }
+        if (isset($this->metadata)) {
+            $data['metadata'] = $this->metadata;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
 /**
@@ -105,8 +181,10 @@ class ReadersTerminateCheckoutRequest
     }
 }
 
-class ReadersUpdateRequest
+class ReadersUpdateRequest implements AdditionalPropertiesInterface, ExplicitFieldsInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Custom human-readable, user-defined name for easier identification of the reader.
      *
@@ -121,20 +199,37 @@ class ReadersUpdateRequest
      */
     public ?array $metadata = null;
 
+    /**
+     * Properties explicitly set through fromArray(), setters, or the constructor with a non-null value.
+     *
+     * @var array<string, true>
+     */
+    private array $explicitFields = [];
+
     /**
      * Create request DTO.
      *
+     * Null arguments are left out of the payload. Send an explicit null
+     * through a setter or fromArray() to clear a field.
+     *
      * @param string|null $name
      * @param array<string, mixed>|null $metadata
      */
-    public function __construct(
-        ?string $name = null,
-        ?array $metadata = null
-    ) {
-        \SumUp\Hydrator::hydrate([
+    public function __construct(?string $name = null, ?array $metadata = null)
+    {
+        $this->fill([
+            'name' => $name,
+            'metadata' => $metadata,
+        ]);
+
+        foreach ([
             'name' => $name,
             'metadata' => $metadata,
-        ], self::class, $this);
+        ] as $propertyName => $value) {
+            if ($value !== null) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
     }
 
     /**
@@ -144,22 +239,172 @@ class ReadersUpdateRequest
      */
     public static function fromArray(array $data): self
     {
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self();
+        $request->fill($data);
+        $request->markExplicitFields($data);
 
         return $request;
     }
 
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'name':
+                    $this->name = $value === null ? null : (string) $value;
+                    break;
+                case 'metadata':
+                    $this->metadata = $value === null ? null : (array) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->name)) {
+            $data['name'] = $this->name;
+        } elseif (isset($this->explicitFields['name'])) {
+            $data['name'] = null;
+        }
+        if (isset($this->metadata)) {
+            $data['metadata'] = $this->metadata;
+        } elseif (isset($this->explicitFields['metadata'])) {
+            $data['metadata'] = null;
+        }
+
+        return $data + $this->additionalProperties();
+    }
+
+    /**
+     * Set name, sending an explicit null when the value is null.
+     *
+     * @param string|null $name
+     */
+    public function setName(?string $name): self
+    {
+        $this->fill(['name' => $name]);
+        $this->explicitFields['name'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set metadata, sending an explicit null when the value is null.
+     *
+     * @param array<string, mixed>|null $metadata
+     */
+    public function setMetadata(?array $metadata): self
+    {
+        $this->fill(['metadata' => $metadata]);
+        $this->explicitFields['metadata'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Names of the properties explicitly set, including those set to null.
+     *
+     * @return string[]
+     */
+    public function explicitFields(): array
+    {
+        return array_keys($this->explicitFields);
+    }
+
+    /**
+     * @param array<string, mixed> $data
+     */
+    private function markExplicitFields(array $data): void
+    {
+        foreach ([
+            'name' => 'name',
+            'metadata' => 'metadata',
+        ] as $serializedName => $propertyName) {
+            if (array_key_exists($serializedName, $data) || array_key_exists($propertyName, $data)) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
+    }
 }
 
-class ReadersListResponse
+class ReadersListResponse implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
-     *
-     * @var \SumUp\Types\Reader[]
+     * @var Reader[]
      */
     public array $items;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'items':
+                    $this->items = array_map(static fn ($item) => $item instanceof Reader ? $item : Reader::fromArray($item), (array) $value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->items)) {
+            $data['items'] = array_map(static fn (Reader $item) => $item->toArray(), $this->items);
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
 /**
@@ -204,14 +449,17 @@ class Readers implements SumUpService
      * @param ReadersCreateRequest|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Reader
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Reader
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function create(string $merchantCode, ReadersCreateRequest|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\Reader
-    {
+    public function create(
+        string $merchantCode,
+        ReadersCreateRequest|array $body,
+        ?RequestOptions $requestOptions = null
+    ): Reader {
         $path = sprintf('/v0.1/merchants/%s/readers', rawurlencode((string) $merchantCode));
         $payload = [];
         $requestBody = $body;
@@ -224,11 +472,11 @@ class Readers implements SumUpService
         $response = $this->client->send('POST', $path, $payload, $headers, $requestOptions);
 
         return ResponseDecoder::decodeOrThrow($response, [
-            '201' => ['type' => 'class', 'class' => \SumUp\Types\Reader::class],
+            '201' => ['type' => 'class', 'class' => Reader::class],
         ], [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '409' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
+            '409' => ['type' => 'class', 'class' => Problem::class],
         ], 'POST', $path);
     }
 
@@ -237,22 +485,26 @@ class Readers implements SumUpService
      *
      * @param string $merchantCode Merchant Code
      * @param string $readerId The unique identifier of the Reader
-     * @param \SumUp\Types\CreateReaderCheckoutRequest|array<string, mixed> $body Required request payload
+     * @param CreateReaderCheckoutRequest|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\CreateReaderCheckoutResponse
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return CreateReaderCheckoutResponse
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function createCheckout(string $merchantCode, string $readerId, \SumUp\Types\CreateReaderCheckoutRequest|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\CreateReaderCheckoutResponse
-    {
+    public function createCheckout(
+        string $merchantCode,
+        string $readerId,
+        CreateReaderCheckoutRequest|array $body,
+        ?RequestOptions $requestOptions = null
+    ): CreateReaderCheckoutResponse {
         $path = sprintf('/v0.1/merchants/%s/readers/%s/checkout', rawurlencode((string) $merchantCode), rawurlencode((string) $readerId));
         $payload = [];
         $requestBody = $body;
         if (is_array($requestBody)) {
-            $requestBody = \SumUp\Types\CreateReaderCheckoutRequest::fromArray($requestBody);
+            $requestBody = CreateReaderCheckoutRequest::fromArray($requestBody);
         }
         $payload = RequestEncoder::encode($requestBody);
         $headers = RequestHeaders::build($this->accessToken, $requestOptions);
@@ -260,12 +512,12 @@ class Readers implements SumUpService
         $response = $this->client->send('POST', $path, $payload, $headers, $requestOptions);
 
         return ResponseDecoder::decodeOrThrow($response, [
-            '201' => ['type' => 'class', 'class' => \SumUp\Types\CreateReaderCheckoutResponse::class],
+            '201' => ['type' => 'class', 'class' => CreateReaderCheckoutResponse::class],
         ], [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '422' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
+            '422' => ['type' => 'class', 'class' => Problem::class],
         ], 'POST', $path);
     }
 
@@ -274,33 +526,37 @@ class Readers implements SumUpService
      *
      * @param string $merchantCode Short unique identifier for the merchant.
      * @param string $readerId The unique identifier of the reader.
-     * @param \SumUp\Types\ReaderPaymentRequestParams|array<string, mixed> $body Required request payload
+     * @param ReaderPaymentRequestParams|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\ReaderPaymentResponse
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return ReaderPaymentResponse
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function createGoCheckout(string $merchantCode, string $readerId, \SumUp\Types\ReaderPaymentRequestParams|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\ReaderPaymentResponse
-    {
+    public function createGoCheckout(
+        string $merchantCode,
+        string $readerId,
+        ReaderPaymentRequestParams|array $body,
+        ?RequestOptions $requestOptions = null
+    ): ReaderPaymentResponse {
         $path = sprintf('/v0/merchants/%s/readers/%s/go-checkout', rawurlencode((string) $merchantCode), rawurlencode((string) $readerId));
         $payload = [];
         $requestBody = $body;
         if (is_array($requestBody)) {
-            $requestBody = \SumUp\Types\ReaderPaymentRequestParams::fromArray($requestBody);
+            $requestBody = ReaderPaymentRequestParams::fromArray($requestBody);
         }
         $payload = RequestEncoder::encode($requestBody);
         $headers = RequestHeaders::build($this->accessToken, $requestOptions);
 
         $response = $this->client->send('POST', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\ReaderPaymentResponse::class, [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '422' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, ReaderPaymentResponse::class, [
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
+            '422' => ['type' => 'class', 'class' => Problem::class],
         ], 'POST', $path);
     }
 
@@ -312,10 +568,10 @@ class Readers implements SumUpService
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
      * @return null
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
     public function delete(string $merchantCode, string $readerId, ?RequestOptions $requestOptions = null): null
     {
@@ -328,7 +584,7 @@ class Readers implements SumUpService
         return ResponseDecoder::decodeOrThrow($response, [
             '200' => ['type' => 'void'],
         ], [
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'DELETE', $path);
     }
 
@@ -339,13 +595,13 @@ class Readers implements SumUpService
      * @param string $readerId The unique identifier of the reader.
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Reader
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Reader
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function get(string $merchantCode, string $readerId, ?RequestOptions $requestOptions = null): \SumUp\Types\Reader
+    public function get(string $merchantCode, string $readerId, ?RequestOptions $requestOptions = null): Reader
     {
         $path = sprintf('/v0.1/merchants/%s/readers/%s', rawurlencode((string) $merchantCode), rawurlencode((string) $readerId));
         $payload = [];
@@ -353,8 +609,8 @@ class Readers implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Reader::class, [
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, Reader::class, [
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -366,23 +622,27 @@ class Readers implements SumUpService
      * @param string $checkoutId The unique identifier of the Checkout
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\GetReaderCheckoutResponse
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return GetReaderCheckoutResponse
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function getCheckout(string $merchantCode, string $readerId, string $checkoutId, ?RequestOptions $requestOptions = null): \SumUp\Types\GetReaderCheckoutResponse
-    {
+    public function getCheckout(
+        string $merchantCode,
+        string $readerId,
+        string $checkoutId,
+        ?RequestOptions $requestOptions = null
+    ): GetReaderCheckoutResponse {
         $path = sprintf('/v0.1/merchants/%s/readers/%s/checkout/%s', rawurlencode((string) $merchantCode), rawurlencode((string) $readerId), rawurlencode((string) $checkoutId));
         $payload = [];
         $headers = RequestHeaders::build($this->accessToken, $requestOptions);
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\GetReaderCheckoutResponse::class, [
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, GetReaderCheckoutResponse::class, [
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -393,24 +653,27 @@ class Readers implements SumUpService
      * @param string $readerId The unique identifier of the Reader
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\StatusResponse
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return StatusResponse
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function getStatus(string $merchantCode, string $readerId, ?RequestOptions $requestOptions = null): \SumUp\Types\StatusResponse
-    {
+    public function getStatus(
+        string $merchantCode,
+        string $readerId,
+        ?RequestOptions $requestOptions = null
+    ): StatusResponse {
         $path = sprintf('/v0.1/merchants/%s/readers/%s/status', rawurlencode((string) $merchantCode), rawurlencode((string) $readerId));
         $payload = [];
         $headers = RequestHeaders::build($this->accessToken, $requestOptions);
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\StatusResponse::class, [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, StatusResponse::class, [
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -420,13 +683,13 @@ class Readers implements SumUpService
      * @param string $merchantCode Short unique identifier for the merchant.
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Services\ReadersListResponse
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return ReadersListResponse
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function list(string $merchantCode, ?RequestOptions $requestOptions = null): \SumUp\Services\ReadersListResponse
+    public function list(string $merchantCode, ?RequestOptions $requestOptions = null): ReadersListResponse
     {
         $path = sprintf('/v0.1/merchants/%s/readers', rawurlencode((string) $merchantCode));
         $payload = [];
@@ -434,8 +697,8 @@ class Readers implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Services\ReadersListResponse::class, [
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, ReadersListResponse::class, [
+            '401' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -448,13 +711,17 @@ class Readers implements SumUpService
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
      * @return null
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function terminateCheckout(string $merchantCode, string $readerId, ReadersTerminateCheckoutRequest|array|null $body = null, ?RequestOptions $requestOptions = null): null
-    {
+    public function terminateCheckout(
+        string $merchantCode,
+        string $readerId,
+        ReadersTerminateCheckoutRequest|array|null $body = null,
+        ?RequestOptions $requestOptions = null
+    ): null {
         $path = sprintf('/v0.1/merchants/%s/readers/%s/terminate', rawurlencode((string) $merchantCode), rawurlencode((string) $readerId));
         $payload = [];
         if ($body !== null) {
@@ -471,10 +738,10 @@ class Readers implements SumUpService
         return ResponseDecoder::decodeOrThrow($response, [
             '202' => ['type' => 'void'],
         ], [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '422' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
+            '422' => ['type' => 'class', 'class' => Problem::class],
         ], 'POST', $path);
     }
 
@@ -486,14 +753,18 @@ class Readers implements SumUpService
      * @param ReadersUpdateRequest|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Reader
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Reader
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function update(string $merchantCode, string $readerId, ReadersUpdateRequest|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\Reader
-    {
+    public function update(
+        string $merchantCode,
+        string $readerId,
+        ReadersUpdateRequest|array $body,
+        ?RequestOptions $requestOptions = null
+    ): Reader {
         $path = sprintf('/v0.1/merchants/%s/readers/%s', rawurlencode((string) $merchantCode), rawurlencode((string) $readerId));
         $payload = [];
         $requestBody = $body;
@@ -505,9 +776,9 @@ class Readers implements SumUpService
 
         $response = $this->client->send('PATCH', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Reader::class, [
-            '403' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, Reader::class, [
+            '403' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'PATCH', $path);
     }
 }
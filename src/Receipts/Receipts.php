@@ -6,10 +6,17 @@ namespace SumUp\Receipts;
 
 namespace SumUp\Services;
 
+use SumUp\Exception\ApiException;
+use SumUp\Exception\ConnectionException;
+use SumUp\Exception\SDKException;
+use SumUp\Exception\UnexpectedApiException;
 use SumUp\HttpClient\HttpClientInterface;
 use SumUp\HttpClient\RequestHeaders;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\ResponseDecoder;
+use SumUp\Types\Error;
+use SumUp\Types\Problem;
+use SumUp\Types\Receipt;
 
 /**
  * Query parameters for ReceiptsGetParams.
@@ -31,7 +38,6 @@ class ReceiptsGetParams
      * @var int|null
      */
     public ?int $txEventId = null;
-
 }
 
 /**
@@ -76,14 +82,17 @@ class Receipts implements SumUpService
      * @param ReceiptsGetParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Receipt
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Receipt
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function get(string $transactionId, ?ReceiptsGetParams $queryParams = null, ?RequestOptions $requestOptions = null): \SumUp\Types\Receipt
-    {
+    public function get(
+        string $transactionId,
+        ?ReceiptsGetParams $queryParams = null,
+        ?RequestOptions $requestOptions = null
+    ): Receipt {
         $path = sprintf('/v1.1/receipts/%s', rawurlencode((string) $transactionId));
         if ($queryParams !== null) {
             $queryParamsData = [];
@@ -105,10 +114,10 @@ class Receipts implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Receipt::class, [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+        return ResponseDecoder::decodeOrThrow($response, Receipt::class, [
+            '400' => ['type' => 'class', 'class' => Error::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Error::class],
         ], 'GET', $path);
     }
 }
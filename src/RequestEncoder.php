@@ -4,6 +4,12 @@ namespace SumUp;
 
 /**
  * Encodes request DTO objects into payload arrays.
+ *
+ * Null properties are omitted unless the DTO implements ExplicitFieldsInterface
+ * and reports them as explicitly set, in which case an explicit null is sent.
+ * Additional properties of DTOs implementing AdditionalPropertiesInterface are
+ * sent as well, without overriding declared properties. DTOs providing a
+ * toArray() method encode themselves.
  */
 class RequestEncoder
 {
@@ -41,6 +47,14 @@ class RequestEncoder
             return $value->value;
         }
 
+        if (is_object($value) && method_exists($value, 'toArray')) {
+            return self::normalize($value->toArray());
+        }
+
+        if ($value instanceof \ArrayObject) {
+            $value = $value->getArrayCopy();
+        }
+
         if (is_array($value)) {
             $result = [];
             foreach ($value as $key => $item) {
@@ -54,14 +68,27 @@ class RequestEncoder
             return $value;
         }
 
+        $explicitFields = [];
+        if ($value instanceof ExplicitFieldsInterface) {
+            $explicitFields = array_flip($value->explicitFields());
+        }
+
         $result = [];
         foreach (get_object_vars($value) as $key => $item) {
-            if ($item === null) {
+            if ($item === null && !isset($explicitFields[$key])) {
                 continue;
             }
             $result[self::toSnakeCase((string) $key)] = self::normalize($item);
         }
 
+        if ($value instanceof AdditionalPropertiesInterface) {
+            foreach ($value->additionalProperties() as $key => $item) {
+                if (!array_key_exists($key, $result)) {
+                    $result[$key] = self::normalize($item);
+                }
+            }
+        }
+
         return $result;
     }
 
@@ -6,14 +6,25 @@ namespace SumUp\Roles;
 
 namespace SumUp\Services;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+use SumUp\Exception\ApiException;
+use SumUp\Exception\ConnectionException;
+use SumUp\Exception\SDKException;
+use SumUp\Exception\UnexpectedApiException;
+use SumUp\ExplicitFieldsInterface;
 use SumUp\HttpClient\HttpClientInterface;
 use SumUp\HttpClient\RequestHeaders;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\RequestEncoder;
 use SumUp\ResponseDecoder;
+use SumUp\Types\Problem;
+use SumUp\Types\Role;
 
-class RolesCreateRequest
+class RolesCreateRequest implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * User-defined name of the role.
      *
@@ -50,18 +61,14 @@ class RolesCreateRequest
      * @param array<string, mixed>|null $metadata
      * @param string|null $description
      */
-    public function __construct(
-        string $name,
-        array $permissions,
-        ?array $metadata = null,
-        ?string $description = null
-    ) {
-        \SumUp\Hydrator::hydrate([
+    public function __construct(string $name, array $permissions, ?array $metadata = null, ?string $description = null)
+    {
+        $this->fill([
             'name' => $name,
             'permissions' => $permissions,
             'metadata' => $metadata,
             'description' => $description,
-        ], self::class, $this);
+        ]);
     }
 
     /**
@@ -71,13 +78,16 @@ class RolesCreateRequest
      */
     public static function fromArray(array $data): self
     {
-        self::assertRequiredFields($data, [
+        $required = self::requiredFields($data, [
             'name' => 'name',
             'permissions' => 'permissions',
         ]);
 
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self(
+            (string) $required['name'],
+            array_map(static fn ($item) => (string) $item, (array) $required['permissions'])
+        );
+        $request->fill($data);
 
         return $request;
     }
@@ -85,20 +95,86 @@ class RolesCreateRequest
     /**
      * @param array<string, mixed> $data
      * @param array<string, string> $requiredFields
+     *
+     * @return array<string, mixed>
      */
-    private static function assertRequiredFields(array $data, array $requiredFields): void
+    private static function requiredFields(array $data, array $requiredFields): array
     {
+        $values = [];
         foreach ($requiredFields as $serializedName => $propertyName) {
-            if (!array_key_exists($serializedName, $data) && !array_key_exists($propertyName, $data)) {
+            if (array_key_exists($serializedName, $data)) {
+                $values[$propertyName] = $data[$serializedName];
+            } elseif (array_key_exists($propertyName, $data)) {
+                $values[$propertyName] = $data[$propertyName];
+            } else {
                 throw new \InvalidArgumentException(sprintf('Missing required field "%s".', $serializedName));
             }
         }
+
+        return $values;
     }
 
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'name':
+                    $this->name = (string) $value;
+                    break;
+                case 'permissions':
+                    $this->permissions = array_map(static fn ($item) => (string) $item, (array) $value);
+                    break;
+                case 'metadata':
+                    $this->metadata = $value === null ? null : (array) $value;
+                    break;
+                case 'description':
+                    $this->description = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->name)) {
+            $data['name'] = $this->name;
+        }
+        if (isset($this->permissions)) {
+            $data['permissions'] = $this->permissions;
+        }
+        if (isset($this->metadata)) {
+            $data['metadata'] = $this->metadata;
+        }
+        if (isset($this->description)) {
+            $data['description'] = $this->description;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
-class RolesUpdateRequest
+class RolesUpdateRequest implements AdditionalPropertiesInterface, ExplicitFieldsInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * User-defined name of the role.
      *
@@ -120,23 +196,40 @@ class RolesUpdateRequest
      */
     public ?string $description = null;
 
+    /**
+     * Properties explicitly set through fromArray(), setters, or the constructor with a non-null value.
+     *
+     * @var array<string, true>
+     */
+    private array $explicitFields = [];
+
     /**
      * Create request DTO.
      *
+     * Null arguments are left out of the payload. Send an explicit null
+     * through a setter or fromArray() to clear a field.
+     *
      * @param string|null $name
      * @param string[]|null $permissions
      * @param string|null $description
      */
-    public function __construct(
-        ?string $name = null,
-        ?array $permissions = null,
-        ?string $description = null
-    ) {
-        \SumUp\Hydrator::hydrate([
+    public function __construct(?string $name = null, ?array $permissions = null, ?string $description = null)
+    {
+        $this->fill([
+            'name' => $name,
+            'permissions' => $permissions,
+            'description' => $description,
+        ]);
+
+        foreach ([
             'name' => $name,
             'permissions' => $permissions,
             'description' => $description,
-        ], self::class, $this);
+        ] as $propertyName => $value) {
+            if ($value !== null) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
     }
 
     /**
@@ -146,22 +239,194 @@ class RolesUpdateRequest
      */
     public static function fromArray(array $data): self
     {
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self();
+        $request->fill($data);
+        $request->markExplicitFields($data);
 
         return $request;
     }
 
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'name':
+                    $this->name = $value === null ? null : (string) $value;
+                    break;
+                case 'permissions':
+                    $this->permissions = $value === null ? null : array_map(static fn ($item) => (string) $item, (array) $value);
+                    break;
+                case 'description':
+                    $this->description = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->name)) {
+            $data['name'] = $this->name;
+        } elseif (isset($this->explicitFields['name'])) {
+            $data['name'] = null;
+        }
+        if (isset($this->permissions)) {
+            $data['permissions'] = $this->permissions;
+        } elseif (isset($this->explicitFields['permissions'])) {
+            $data['permissions'] = null;
+        }
+        if (isset($this->description)) {
+            $data['description'] = $this->description;
+        } elseif (isset($this->explicitFields['description'])) {
+            $data['description'] = null;
+        }
+
+        return $data + $this->additionalProperties();
+    }
+
+    /**
+     * Set name, sending an explicit null when the value is null.
+     *
+     * @param string|null $name
+     */
+    public function setName(?string $name): self
+    {
+        $this->fill(['name' => $name]);
+        $this->explicitFields['name'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set permissions, sending an explicit null when the value is null.
+     *
+     * @param string[]|null $permissions
+     */
+    public function setPermissions(?array $permissions): self
+    {
+        $this->fill(['permissions' => $permissions]);
+        $this->explicitFields['permissions'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set description, sending an explicit null when the value is null.
+     *
+     * @param string|null $description
+     */
+    public function setDescription(?string $description): self
+    {
+        $this->fill(['description' => $description]);
+        $this->explicitFields['description'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Names of the properties explicitly set, including those set to null.
+     *
+     * @return string[]
+     */
+    public function explicitFields(): array
+    {
+        return array_keys($this->explicitFields);
+    }
+
+    /**
+     * @param array<string, mixed> $data
+     */
+    private function markExplicitFields(array $data): void
+    {
+        foreach ([
+            'name' => 'name',
+            'permissions' => 'permissions',
+            'description' => 'description',
+        ] as $serializedName => $propertyName) {
+            if (array_key_exists($serializedName, $data) || array_key_exists($propertyName, $data)) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
+    }
 }
 
-class RolesListResponse
+class RolesListResponse implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
-     *
-     * @var \SumUp\Types\Role[]
+     * @var Role[]
      */
     public array $items;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'items':
+                    $this->items = array_map(static fn ($item) => $item instanceof Role ? $item : Role::fromArray($item), (array) $value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->items)) {
+            $data['items'] = array_map(static fn (Role $item) => $item->toArray(), $this->items);
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
 /**
@@ -206,14 +471,19 @@ class Roles implements SumUpService
      * @param RolesCreateRequest|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Role
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Role
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
-    public function create(string $merchantCode, RolesCreateRequest|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\Role
-    {
+    public function create(
+        string $merchantCode,
+        RolesCreateRequest|array $body,
+        ?RequestOptions $requestOptions = null
+    ): Role {
         $path = sprintf('/v0.1/merchants/%s/roles', rawurlencode((string) $merchantCode));
         $payload = [];
         $requestBody = $body;
@@ -226,10 +496,10 @@ class Roles implements SumUpService
         $response = $this->client->send('POST', $path, $payload, $headers, $requestOptions);
 
         return ResponseDecoder::decodeOrThrow($response, [
-            '201' => ['type' => 'class', 'class' => \SumUp\Types\Role::class],
+            '201' => ['type' => 'class', 'class' => Role::class],
         ], [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'POST', $path);
     }
 
@@ -241,10 +511,12 @@ class Roles implements SumUpService
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
      * @return null
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
     public function delete(string $merchantCode, string $roleId, ?RequestOptions $requestOptions = null): null
     {
@@ -257,8 +529,8 @@ class Roles implements SumUpService
         return ResponseDecoder::decodeOrThrow($response, [
             '200' => ['type' => 'void'],
         ], [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'DELETE', $path);
     }
 
@@ -269,13 +541,15 @@ class Roles implements SumUpService
      * @param string $roleId The ID of the role to retrieve.
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Role
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Role
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
-    public function get(string $merchantCode, string $roleId, ?RequestOptions $requestOptions = null): \SumUp\Types\Role
+    public function get(string $merchantCode, string $roleId, ?RequestOptions $requestOptions = null): Role
     {
         $path = sprintf('/v0.1/merchants/%s/roles/%s', rawurlencode((string) $merchantCode), rawurlencode((string) $roleId));
         $payload = [];
@@ -283,8 +557,8 @@ class Roles implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Role::class, [
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, Role::class, [
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -294,13 +568,15 @@ class Roles implements SumUpService
      * @param string $merchantCode Short unique identifier for the merchant.
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Services\RolesListResponse
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return RolesListResponse
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
-    public function list(string $merchantCode, ?RequestOptions $requestOptions = null): \SumUp\Services\RolesListResponse
+    public function list(string $merchantCode, ?RequestOptions $requestOptions = null): RolesListResponse
     {
         $path = sprintf('/v0.1/merchants/%s/roles', rawurlencode((string) $merchantCode));
         $payload = [];
@@ -308,8 +584,8 @@ class Roles implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Services\RolesListResponse::class, [
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, RolesListResponse::class, [
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -321,14 +597,20 @@ class Roles implements SumUpService
      * @param RolesUpdateRequest|array<string, mixed> $body Required request payload
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\Role
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return Role
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
+     *
+     * @experimental
      */
-    public function update(string $merchantCode, string $roleId, RolesUpdateRequest|array $body, ?RequestOptions $requestOptions = null): \SumUp\Types\Role
-    {
+    public function update(
+        string $merchantCode,
+        string $roleId,
+        RolesUpdateRequest|array $body,
+        ?RequestOptions $requestOptions = null
+    ): Role {
         $path = sprintf('/v0.1/merchants/%s/roles/%s', rawurlencode((string) $merchantCode), rawurlencode((string) $roleId));
         $payload = [];
         $requestBody = $body;
@@ -340,9 +622,9 @@ class Roles implements SumUpService
 
         $response = $this->client->send('PATCH', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\Role::class, [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, Role::class, [
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
         ], 'PATCH', $path);
     }
 }
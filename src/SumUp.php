@@ -47,8 +47,12 @@ class SumUp
      *
      * @throws SDKException
      */
-    public function __construct(string|array|null $configOrApiKey = null)
+    public function __construct($configOrApiKey = null)
     {
+        if ($configOrApiKey !== null && !is_string($configOrApiKey) && !is_array($configOrApiKey)) {
+            throw new \TypeError(sprintf('SumUp expects an API key, a configuration array or null, %s given.', gettype($configOrApiKey)));
+        }
+
         $config = [];
         if (is_string($configOrApiKey) && $configOrApiKey !== '') {
             $config['api_key'] = $configOrApiKey;
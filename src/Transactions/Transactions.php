@@ -6,17 +6,30 @@ namespace SumUp\Transactions;
 
 namespace SumUp\Services;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+use SumUp\Exception\ApiException;
+use SumUp\Exception\ConnectionException;
+use SumUp\Exception\SDKException;
+use SumUp\Exception\UnexpectedApiException;
 use SumUp\HttpClient\HttpClientInterface;
 use SumUp\HttpClient\RequestHeaders;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\RequestEncoder;
 use SumUp\ResponseDecoder;
+use SumUp\Types\Error;
+use SumUp\Types\Problem;
+use SumUp\Types\TransactionFull;
+use SumUp\Types\TransactionHistory;
+use SumUp\Types\TransactionsHistoryLink;
 
 /**
  * Optional amount for partial refunds of transactions.
  */
-class TransactionsRefundRequest
+class TransactionsRefundRequest implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Amount to be refunded. Eligible amount can't exceed the amount of the transaction and varies based on country and currency. If you do not specify a value, the system performs a full refund of the transaction.
      *
@@ -29,12 +42,11 @@ class TransactionsRefundRequest
      *
      * @param float|null $amount
      */
-    public function __construct(
-        ?float $amount = null
-    ) {
-        \SumUp\Hydrator::hydrate([
+    public function __construct(?float $amount = null)
+    {
+        $this->fill([
             'amount' => $amount,
-        ], self::class, $this);
+        ]);
     }
 
     /**
@@ -44,30 +56,125 @@ class TransactionsRefundRequest
      */
     public static function fromArray(array $data): self
     {
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self();
+        $request->fill($data);
 
         return $request;
     }
 
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'amount':
+                    $this->amount = $value === null ? null : (float) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
-class TransactionsListResponse
+class TransactionsListResponse implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Transactions in the current result page.
      *
-     * @var \SumUp\Types\TransactionHistory[]|null
+     * @var TransactionHistory[]|null
      */
     public ?array $items = null;
 
     /**
      * Pagination links for navigating the transaction history.
      *
-     * @var \SumUp\Types\TransactionsHistoryLink[]|null
+     * @var TransactionsHistoryLink[]|null
      */
     public ?array $links = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'items':
+                    $this->items = $value === null ? null : array_map(static fn ($item) => $item instanceof TransactionHistory ? $item : TransactionHistory::fromArray($item), (array) $value);
+                    break;
+                case 'links':
+                    $this->links = $value === null ? null : array_map(static fn ($item) => $item instanceof TransactionsHistoryLink ? $item : TransactionsHistoryLink::fromArray($item), (array) $value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->items)) {
+            $data['items'] = array_map(static fn (TransactionHistory $item) => $item->toArray(), $this->items);
+        }
+        if (isset($this->links)) {
+            $data['links'] = array_map(static fn (TransactionsHistoryLink $item) => $item->toArray(), $this->links);
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
 
 /**
@@ -104,7 +211,6 @@ class TransactionsGetParams
      * @var string|null
      */
     public ?string $clientTransactionId = null;
-
 }
 
 /**
@@ -126,7 +232,7 @@ class TransactionsListParams
      *
      * @var string|null
      */
-    public ?string $order = null;
+    public ?string $order = 'ascending';
 
     /**
      * Specifies the maximum number of results per page. Value must be a positive integer and if not specified, will return 10 results.
@@ -204,7 +310,6 @@ class TransactionsListParams
      * @var string|null
      */
     public ?string $oldestRef = null;
-
 }
 
 /**
@@ -266,14 +371,17 @@ class Transactions implements SumUpService
      * @param TransactionsGetParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Types\TransactionFull
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return TransactionFull
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function get(string $merchantCode, ?TransactionsGetParams $queryParams = null, ?RequestOptions $requestOptions = null): \SumUp\Types\TransactionFull
-    {
+    public function get(
+        string $merchantCode,
+        ?TransactionsGetParams $queryParams = null,
+        ?RequestOptions $requestOptions = null
+    ): TransactionFull {
         $path = sprintf('/v2.1/merchants/%s/transactions', rawurlencode((string) $merchantCode));
         if ($queryParams !== null) {
             $queryParamsData = [];
@@ -301,9 +409,9 @@ class Transactions implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Types\TransactionFull::class, [
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
+        return ResponseDecoder::decodeOrThrow($response, TransactionFull::class, [
+            '401' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Error::class],
         ], 'GET', $path);
     }
 
@@ -314,21 +422,24 @@ class Transactions implements SumUpService
      * @param TransactionsListParams|null $queryParams Optional query string parameters
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
-     * @return \SumUp\Services\TransactionsListResponse
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @return TransactionsListResponse
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function list(string $merchantCode, ?TransactionsListParams $queryParams = null, ?RequestOptions $requestOptions = null): \SumUp\Services\TransactionsListResponse
-    {
+    public function list(
+        string $merchantCode,
+        ?TransactionsListParams $queryParams = null,
+        ?RequestOptions $requestOptions = null
+    ): TransactionsListResponse {
         $path = sprintf('/v2.1/merchants/%s/transactions/history', rawurlencode((string) $merchantCode));
         if ($queryParams !== null) {
             $queryParamsData = [];
             if (isset($queryParams->transactionCode)) {
                 $queryParamsData['transaction_code'] = $queryParams->transactionCode;
             }
-            if (isset($queryParams->order)) {
+            if (isset($queryParams->order) && $queryParams->order !== 'ascending') {
                 $queryParamsData['order'] = $queryParams->order;
             }
             if (isset($queryParams->limit)) {
@@ -376,9 +487,9 @@ class Transactions implements SumUpService
 
         $response = $this->client->send('GET', $path, $payload, $headers, $requestOptions);
 
-        return ResponseDecoder::decodeOrThrow($response, \SumUp\Services\TransactionsListResponse::class, [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Error::class],
-            '401' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+        return ResponseDecoder::decodeOrThrow($response, TransactionsListResponse::class, [
+            '400' => ['type' => 'class', 'class' => Error::class],
+            '401' => ['type' => 'class', 'class' => Problem::class],
         ], 'GET', $path);
     }
 
@@ -391,13 +502,17 @@ class Transactions implements SumUpService
      * @param RequestOptions|null $requestOptions Optional typed request options
      *
      * @return array<string, mixed>
-     * @throws \SumUp\Exception\ApiException
-     * @throws \SumUp\Exception\UnexpectedApiException
-     * @throws \SumUp\Exception\ConnectionException
-     * @throws \SumUp\Exception\SDKException
+     * @throws ApiException
+     * @throws UnexpectedApiException
+     * @throws ConnectionException
+     * @throws SDKException
      */
-    public function refund(string $merchantCode, string $transactionId, TransactionsRefundRequest|array|null $body = null, ?RequestOptions $requestOptions = null): array
-    {
+    public function refund(
+        string $merchantCode,
+        string $transactionId,
+        TransactionsRefundRequest|array|null $body = null,
+        ?RequestOptions $requestOptions = null
+    ): array {
         $path = sprintf('/v1.0/merchants/%s/payments/%s/refunds', rawurlencode((string) $merchantCode), rawurlencode((string) $transactionId));
         $payload = [];
         if ($body !== null) {
@@ -414,11 +529,11 @@ class Transactions implements SumUpService
         return ResponseDecoder::decodeOrThrow($response, [
             '201' => ['type' => 'object'],
         ], [
-            '400' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '403' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '404' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '409' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
-            '422' => ['type' => 'class', 'class' => \SumUp\Types\Problem::class],
+            '400' => ['type' => 'class', 'class' => Problem::class],
+            '403' => ['type' => 'class', 'class' => Problem::class],
+            '404' => ['type' => 'class', 'class' => Problem::class],
+            '409' => ['type' => 'class', 'class' => Problem::class],
+            '422' => ['type' => 'class', 'class' => Problem::class],
         ], 'POST', $path);
     }
 }
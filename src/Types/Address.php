@@ -4,14 +4,18 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * An address somewhere in the world. The address fields used depend on the country conventions. For example, in Great Britain, `city` is `post_town`. In the United States, the top-level administrative unit used in addresses is `state`, whereas in Chile it's `region`.
  * Whether an address is valid or not depends on whether the locally required fields are present. Fields not supported in a country will be ignored.
  */
-class Address
+class Address implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
-     *
      * @var string[]|null
      */
     public ?array $streetAddress = null;
@@ -130,4 +134,155 @@ class Address
      */
     public ?string $eircode = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'street_address':
+                case 'streetAddress':
+                    $this->streetAddress = $value === null ? null : array_map(static fn ($item) => (string) $item, (array) $value);
+                    break;
+                case 'post_code':
+                case 'postCode':
+                    $this->postCode = $value === null ? null : (string) $value;
+                    break;
+                case 'country':
+                    $this->country = (string) $value;
+                    break;
+                case 'city':
+                    $this->city = $value === null ? null : (string) $value;
+                    break;
+                case 'province':
+                    $this->province = $value === null ? null : (string) $value;
+                    break;
+                case 'region':
+                    $this->region = $value === null ? null : (string) $value;
+                    break;
+                case 'county':
+                    $this->county = $value === null ? null : (string) $value;
+                    break;
+                case 'autonomous_community':
+                case 'autonomousCommunity':
+                    $this->autonomousCommunity = $value === null ? null : (string) $value;
+                    break;
+                case 'post_town':
+                case 'postTown':
+                    $this->postTown = $value === null ? null : (string) $value;
+                    break;
+                case 'state':
+                    $this->state = $value === null ? null : (string) $value;
+                    break;
+                case 'neighborhood':
+                    $this->neighborhood = $value === null ? null : (string) $value;
+                    break;
+                case 'commune':
+                    $this->commune = $value === null ? null : (string) $value;
+                    break;
+                case 'department':
+                    $this->department = $value === null ? null : (string) $value;
+                    break;
+                case 'municipality':
+                    $this->municipality = $value === null ? null : (string) $value;
+                    break;
+                case 'district':
+                    $this->district = $value === null ? null : (string) $value;
+                    break;
+                case 'zip_code':
+                case 'zipCode':
+                    $this->zipCode = $value === null ? null : (string) $value;
+                    break;
+                case 'eircode':
+                    $this->eircode = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->streetAddress)) {
+            $data['street_address'] = $this->streetAddress;
+        }
+        if (isset($this->postCode)) {
+            $data['post_code'] = $this->postCode;
+        }
+        if (isset($this->country)) {
+            $data['country'] = $this->country;
+        }
+        if (isset($this->city)) {
+            $data['city'] = $this->city;
+        }
+        if (isset($this->province)) {
+            $data['province'] = $this->province;
+        }
+        if (isset($this->region)) {
+            $data['region'] = $this->region;
+        }
+        if (isset($this->county)) {
+            $data['county'] = $this->county;
+        }
+        if (isset($this->autonomousCommunity)) {
+            $data['autonomous_community'] = $this->autonomousCommunity;
+        }
+        if (isset($this->postTown)) {
+            $data['post_town'] = $this->postTown;
+        }
+        if (isset($this->state)) {
+            $data['state'] = $this->state;
+        }
+        if (isset($this->neighborhood)) {
+            $data['neighborhood'] = $this->neighborhood;
+        }
+        if (isset($this->commune)) {
+            $data['commune'] = $this->commune;
+        }
+        if (isset($this->department)) {
+            $data['department'] = $this->department;
+        }
+        if (isset($this->municipality)) {
+            $data['municipality'] = $this->municipality;
+        }
+        if (isset($this->district)) {
+            $data['district'] = $this->district;
+        }
+        if (isset($this->zipCode)) {
+            $data['zip_code'] = $this->zipCode;
+        }
+        if (isset($this->eircode)) {
+            $data['eircode'] = $this->eircode;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
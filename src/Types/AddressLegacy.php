@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Profile's personal address information.
  */
-class AddressLegacy
+class AddressLegacy implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * City name from the address.
      *
@@ -51,4 +56,87 @@ class AddressLegacy
      */
     public ?string $state = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'city':
+                    $this->city = $value === null ? null : (string) $value;
+                    break;
+                case 'country':
+                    $this->country = $value === null ? null : (string) $value;
+                    break;
+                case 'line_1':
+                case 'line1':
+                    $this->line1 = $value === null ? null : (string) $value;
+                    break;
+                case 'line_2':
+                case 'line2':
+                    $this->line2 = $value === null ? null : (string) $value;
+                    break;
+                case 'postal_code':
+                case 'postalCode':
+                    $this->postalCode = $value === null ? null : (string) $value;
+                    break;
+                case 'state':
+                    $this->state = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->city)) {
+            $data['city'] = $this->city;
+        }
+        if (isset($this->country)) {
+            $data['country'] = $this->country;
+        }
+        if (isset($this->line1)) {
+            $data['line_1'] = $this->line1;
+        }
+        if (isset($this->line2)) {
+            $data['line_2'] = $this->line2;
+        }
+        if (isset($this->postalCode)) {
+            $data['postal_code'] = $this->postalCode;
+        }
+        if (isset($this->state)) {
+            $data['state'] = $this->state;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,18 +4,78 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class Affiliate
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
+class Affiliate implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
-     *
      * @var string
      */
     public string $appId;
 
     /**
-     *
      * @var string
      */
     public string $key;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'app_id':
+                case 'appId':
+                    $this->appId = (string) $value;
+                    break;
+                case 'key':
+                    $this->key = (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->appId)) {
+            $data['app_id'] = $this->appId;
+        }
+        if (isset($this->key)) {
+            $data['key'] = $this->key;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
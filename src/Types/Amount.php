@@ -4,8 +4,13 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class Amount
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
+class Amount implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Currency ISO 4217 code
      *
@@ -20,4 +25,60 @@ class Amount
      */
     public int $value;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'currency':
+                    $this->currency = (string) $value;
+                    break;
+                case 'value':
+                    $this->value = (int) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency;
+        }
+        if (isset($this->value)) {
+            $data['value'] = $this->value;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
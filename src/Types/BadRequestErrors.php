@@ -4,8 +4,13 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class BadRequestErrors
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
+class BadRequestErrors implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Fuller message giving context to error
      *
@@ -20,4 +25,60 @@ class BadRequestErrors
      */
     public BadRequestErrorsType $type;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'detail':
+                    $this->detail = $value === null ? null : (string) $value;
+                    break;
+                case 'type':
+                    $this->type = $value instanceof BadRequestErrorsType ? $value : BadRequestErrorsType::from($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->detail)) {
+            $data['detail'] = $this->detail;
+        }
+        if (isset($this->type)) {
+            $data['type'] = $this->type->value;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
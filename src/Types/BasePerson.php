@@ -4,12 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Base schema for a Person associated with a Merchant. This can be a legal representative, business owner (ultimate beneficial owner), or an officer. A legal representative is the Person who registered the Merchant with SumUp. They should always have a `user_id`.
- *
  */
-class BasePerson
+class BasePerson implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * The unique identifier for the Person. This is a [typeid](https://github.com/sumup/typeid).
      *
@@ -67,7 +71,6 @@ class BasePerson
     public ?array $relationships = null;
 
     /**
-     *
      * @var Ownership|null
      */
     public ?Ownership $ownership = null;
@@ -125,4 +128,151 @@ class BasePerson
      */
     public ?string $changeStatus = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = (string) $value;
+                    break;
+                case 'user_id':
+                case 'userId':
+                    $this->userId = $value === null ? null : (string) $value;
+                    break;
+                case 'birthdate':
+                    $this->birthdate = $value === null ? null : (string) $value;
+                    break;
+                case 'given_name':
+                case 'givenName':
+                    $this->givenName = $value === null ? null : (string) $value;
+                    break;
+                case 'family_name':
+                case 'familyName':
+                    $this->familyName = $value === null ? null : (string) $value;
+                    break;
+                case 'middle_name':
+                case 'middleName':
+                    $this->middleName = $value === null ? null : (string) $value;
+                    break;
+                case 'phone_number':
+                case 'phoneNumber':
+                    $this->phoneNumber = $value === null ? null : (string) $value;
+                    break;
+                case 'relationships':
+                    $this->relationships = $value === null ? null : array_map(static fn ($item) => (string) $item, (array) $value);
+                    break;
+                case 'ownership':
+                    $this->ownership = $value instanceof Ownership || $value === null ? $value : Ownership::fromArray($value);
+                    break;
+                case 'address':
+                    $this->address = $value instanceof Address || $value === null ? $value : Address::fromArray($value);
+                    break;
+                case 'identifiers':
+                    $this->identifiers = $value === null ? null : array_map(static fn ($item) => $item instanceof PersonalIdentifier ? $item : PersonalIdentifier::fromArray($item), (array) $value);
+                    break;
+                case 'citizenship':
+                    $this->citizenship = $value === null ? null : (string) $value;
+                    break;
+                case 'nationality':
+                    $this->nationality = $value === null ? null : (string) $value;
+                    break;
+                case 'country_of_residence':
+                case 'countryOfResidence':
+                    $this->countryOfResidence = $value === null ? null : (string) $value;
+                    break;
+                case 'version':
+                    $this->version = $value === null ? null : (string) $value;
+                    break;
+                case 'change_status':
+                case 'changeStatus':
+                    $this->changeStatus = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->userId)) {
+            $data['user_id'] = $this->userId;
+        }
+        if (isset($this->birthdate)) {
+            $data['birthdate'] = $this->birthdate;
+        }
+        if (isset($this->givenName)) {
+            $data['given_name'] = $this->givenName;
+        }
+        if (isset($this->familyName)) {
+            $data['family_name'] = $this->familyName;
+        }
+        if (isset($this->middleName)) {
+            $data['middle_name'] = $this->middleName;
+        }
+        if (isset($this->phoneNumber)) {
+            $data['phone_number'] = $this->phoneNumber;
+        }
+        if (isset($this->relationships)) {
+            $data['relationships'] = $this->relationships;
+        }
+        if (isset($this->ownership)) {
+            $data['ownership'] = $this->ownership->toArray();
+        }
+        if (isset($this->address)) {
+            $data['address'] = $this->address->toArray();
+        }
+        if (isset($this->identifiers)) {
+            $data['identifiers'] = array_map(static fn (PersonalIdentifier $item) => $item->toArray(), $this->identifiers);
+        }
+        if (isset($this->citizenship)) {
+            $data['citizenship'] = $this->citizenship;
+        }
+        if (isset($this->nationality)) {
+            $data['nationality'] = $this->nationality;
+        }
+        if (isset($this->countryOfResidence)) {
+            $data['country_of_residence'] = $this->countryOfResidence;
+        }
+        if (isset($this->version)) {
+            $data['version'] = $this->version;
+        }
+        if (isset($this->changeStatus)) {
+            $data['change_status'] = $this->changeStatus;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
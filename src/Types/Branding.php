@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Settings used to apply the Merchant's branding to email receipts, invoices, checkouts, and other products.
  */
-class Branding
+class Branding implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Footer text rendered on receipts and other customer-facing products.
      *
@@ -72,4 +77,108 @@ class Branding
      */
     public ?string $backgroundColor = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'footer_text':
+                case 'footerText':
+                    $this->footerText = $value === null ? null : (string) $value;
+                    break;
+                case 'icon':
+                    $this->icon = $value === null ? null : (string) $value;
+                    break;
+                case 'logo':
+                    $this->logo = $value === null ? null : (string) $value;
+                    break;
+                case 'hero':
+                    $this->hero = $value === null ? null : (string) $value;
+                    break;
+                case 'primary_color':
+                case 'primaryColor':
+                    $this->primaryColor = $value === null ? null : (string) $value;
+                    break;
+                case 'primary_color_fg':
+                case 'primaryColorFg':
+                    $this->primaryColorFg = $value === null ? null : (string) $value;
+                    break;
+                case 'secondary_color':
+                case 'secondaryColor':
+                    $this->secondaryColor = $value === null ? null : (string) $value;
+                    break;
+                case 'secondary_color_fg':
+                case 'secondaryColorFg':
+                    $this->secondaryColorFg = $value === null ? null : (string) $value;
+                    break;
+                case 'background_color':
+                case 'backgroundColor':
+                    $this->backgroundColor = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->footerText)) {
+            $data['footer_text'] = $this->footerText;
+        }
+        if (isset($this->icon)) {
+            $data['icon'] = $this->icon;
+        }
+        if (isset($this->logo)) {
+            $data['logo'] = $this->logo;
+        }
+        if (isset($this->hero)) {
+            $data['hero'] = $this->hero;
+        }
+        if (isset($this->primaryColor)) {
+            $data['primary_color'] = $this->primaryColor;
+        }
+        if (isset($this->primaryColorFg)) {
+            $data['primary_color_fg'] = $this->primaryColorFg;
+        }
+        if (isset($this->secondaryColor)) {
+            $data['secondary_color'] = $this->secondaryColor;
+        }
+        if (isset($this->secondaryColorFg)) {
+            $data['secondary_color_fg'] = $this->secondaryColorFg;
+        }
+        if (isset($this->backgroundColor)) {
+            $data['background_color'] = $this->backgroundColor;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
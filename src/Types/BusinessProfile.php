@@ -4,12 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Business information about the merchant. This information will be visible to the merchant's customers.
- *
  */
-class BusinessProfile
+class BusinessProfile implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * The customer-facing business name.
      *
@@ -61,4 +65,92 @@ class BusinessProfile
      */
     public ?Branding $branding = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'name':
+                    $this->name = $value === null ? null : (string) $value;
+                    break;
+                case 'dynamic_descriptor':
+                case 'dynamicDescriptor':
+                    $this->dynamicDescriptor = $value === null ? null : (string) $value;
+                    break;
+                case 'website':
+                    $this->website = $value === null ? null : (string) $value;
+                    break;
+                case 'email':
+                    $this->email = $value === null ? null : (string) $value;
+                    break;
+                case 'phone_number':
+                case 'phoneNumber':
+                    $this->phoneNumber = $value === null ? null : (string) $value;
+                    break;
+                case 'address':
+                    $this->address = $value instanceof Address || $value === null ? $value : Address::fromArray($value);
+                    break;
+                case 'branding':
+                    $this->branding = $value instanceof Branding || $value === null ? $value : Branding::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->name)) {
+            $data['name'] = $this->name;
+        }
+        if (isset($this->dynamicDescriptor)) {
+            $data['dynamic_descriptor'] = $this->dynamicDescriptor;
+        }
+        if (isset($this->website)) {
+            $data['website'] = $this->website;
+        }
+        if (isset($this->email)) {
+            $data['email'] = $this->email;
+        }
+        if (isset($this->phoneNumber)) {
+            $data['phone_number'] = $this->phoneNumber;
+        }
+        if (isset($this->address)) {
+            $data['address'] = $this->address->toArray();
+        }
+        if (isset($this->branding)) {
+            $data['branding'] = $this->branding->toArray();
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Core checkout resource returned by the Checkouts API. A checkout is created before payment processing and then updated as payment attempts, redirects, and resulting transactions are attached to it.
  */
-class Checkout
+class Checkout implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Merchant-defined reference for the checkout. Use it to correlate the SumUp checkout with your own order, cart, subscription, or payment attempt in your systems.
      *
@@ -107,4 +112,138 @@ class Checkout
      */
     public ?array $transactions = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'checkout_reference':
+                case 'checkoutReference':
+                    $this->checkoutReference = $value === null ? null : (string) $value;
+                    break;
+                case 'amount':
+                    $this->amount = $value === null ? null : (float) $value;
+                    break;
+                case 'currency':
+                    $this->currency = $value instanceof CheckoutCurrency || $value === null ? $value : CheckoutCurrency::from($value);
+                    break;
+                case 'merchant_code':
+                case 'merchantCode':
+                    $this->merchantCode = $value === null ? null : (string) $value;
+                    break;
+                case 'description':
+                    $this->description = $value === null ? null : (string) $value;
+                    break;
+                case 'return_url':
+                case 'returnUrl':
+                    $this->returnUrl = $value === null ? null : (string) $value;
+                    break;
+                case 'id':
+                    $this->id = $value === null ? null : (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value instanceof CheckoutStatus || $value === null ? $value : CheckoutStatus::from($value);
+                    break;
+                case 'date':
+                    $this->date = $value === null ? null : (string) $value;
+                    break;
+                case 'valid_until':
+                case 'validUntil':
+                    $this->validUntil = $value === null ? null : (string) $value;
+                    break;
+                case 'customer_id':
+                case 'customerId':
+                    $this->customerId = $value === null ? null : (string) $value;
+                    break;
+                case 'mandate':
+                    $this->mandate = $value instanceof MandateResponse || $value === null ? $value : MandateResponse::fromArray($value);
+                    break;
+                case 'hosted_checkout_url':
+                case 'hostedCheckoutUrl':
+                    $this->hostedCheckoutUrl = $value === null ? null : (string) $value;
+                    break;
+                case 'transactions':
+                    $this->transactions = $value === null ? null : (array) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->checkoutReference)) {
+            $data['checkout_reference'] = $this->checkoutReference;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency->value;
+        }
+        if (isset($this->merchantCode)) {
+            $data['merchant_code'] = $this->merchantCode;
+        }
+        if (isset($this->description)) {
+            $data['description'] = $this->description;
+        }
+        if (isset($this->returnUrl)) {
+            $data['return_url'] = $this->returnUrl;
+        }
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->date)) {
+            $data['date'] = $this->date;
+        }
+        if (isset($this->validUntil)) {
+            $data['valid_until'] = $this->validUntil;
+        }
+        if (isset($this->customerId)) {
+            $data['customer_id'] = $this->customerId;
+        }
+        if (isset($this->mandate)) {
+            $data['mandate'] = $this->mandate->toArray();
+        }
+        if (isset($this->hostedCheckoutUrl)) {
+            $data['hosted_checkout_url'] = $this->hostedCheckoutUrl;
+        }
+        if (isset($this->transactions)) {
+            $data['transactions'] = $this->transactions;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
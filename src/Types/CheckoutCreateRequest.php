@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Request body for creating a checkout before processing payment. Define the payment amount, currency, merchant, and optional customer or redirect behavior here.
  */
-class CheckoutCreateRequest
+class CheckoutCreateRequest implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Merchant-defined reference for the new checkout. It should be unique enough for you to identify the payment attempt in your own systems.
      *
@@ -63,7 +68,7 @@ class CheckoutCreateRequest
      *
      * @var CheckoutCreateRequestPurpose|null
      */
-    public ?CheckoutCreateRequestPurpose $purpose = null;
+    public ?CheckoutCreateRequestPurpose $purpose = CheckoutCreateRequestPurpose::CHECKOUT;
 
     /**
      * Optional expiration timestamp. The checkout must be processed before this moment, otherwise it becomes unusable. If omitted, the checkout does not have an explicit expiry time.
@@ -86,6 +91,13 @@ class CheckoutCreateRequest
      */
     public ?HostedCheckout $hostedCheckout = null;
 
+    /**
+     * Properties explicitly set through fromArray().
+     *
+     * @var array<string, true>
+     */
+    private array $explicitFields = [];
+
     /**
      * Create request DTO.
      *
@@ -109,12 +121,12 @@ class CheckoutCreateRequest
         ?string $description = null,
         ?string $returnUrl = null,
         ?string $customerId = null,
-        CheckoutCreateRequestPurpose|string|null $purpose = null,
+        CheckoutCreateRequestPurpose|string|null $purpose = CheckoutCreateRequestPurpose::CHECKOUT,
         ?string $validUntil = null,
         ?string $redirectUrl = null,
         ?HostedCheckout $hostedCheckout = null
     ) {
-        \SumUp\Hydrator::hydrate([
+        $this->fill([
             'checkout_reference' => $checkoutReference,
             'amount' => $amount,
             'currency' => $currency,
@@ -126,7 +138,7 @@ class CheckoutCreateRequest
             'valid_until' => $validUntil,
             'redirect_url' => $redirectUrl,
             'hosted_checkout' => $hostedCheckout,
-        ], self::class, $this);
+        ]);
     }
 
     /**
@@ -136,15 +148,21 @@ class CheckoutCreateRequest
      */
     public static function fromArray(array $data): self
     {
-        self::assertRequiredFields($data, [
+        $required = self::requiredFields($data, [
             'checkout_reference' => 'checkoutReference',
             'amount' => 'amount',
             'currency' => 'currency',
             'merchant_code' => 'merchantCode',
         ]);
 
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self(
+            (string) $required['checkoutReference'],
+            (float) $required['amount'],
+            $required['currency'] instanceof CheckoutCreateRequestCurrency ? $required['currency'] : CheckoutCreateRequestCurrency::from($required['currency']),
+            (string) $required['merchantCode']
+        );
+        $request->fill($data);
+        $request->markExplicitFields($data);
 
         return $request;
     }
@@ -152,14 +170,141 @@ class CheckoutCreateRequest
     /**
      * @param array<string, mixed> $data
      * @param array<string, string> $requiredFields
+     *
+     * @return array<string, mixed>
      */
-    private static function assertRequiredFields(array $data, array $requiredFields): void
+    private static function requiredFields(array $data, array $requiredFields): array
     {
+        $values = [];
         foreach ($requiredFields as $serializedName => $propertyName) {
-            if (!array_key_exists($serializedName, $data) && !array_key_exists($propertyName, $data)) {
+            if (array_key_exists($serializedName, $data)) {
+                $values[$propertyName] = $data[$serializedName];
+            } elseif (array_key_exists($propertyName, $data)) {
+                $values[$propertyName] = $data[$propertyName];
+            } else {
                 throw new \InvalidArgumentException(sprintf('Missing required field "%s".', $serializedName));
             }
         }
+
+        return $values;
     }
 
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'checkout_reference':
+                case 'checkoutReference':
+                    $this->checkoutReference = (string) $value;
+                    break;
+                case 'amount':
+                    $this->amount = (float) $value;
+                    break;
+                case 'currency':
+                    $this->currency = $value instanceof CheckoutCreateRequestCurrency ? $value : CheckoutCreateRequestCurrency::from($value);
+                    break;
+                case 'merchant_code':
+                case 'merchantCode':
+                    $this->merchantCode = (string) $value;
+                    break;
+                case 'description':
+                    $this->description = $value === null ? null : (string) $value;
+                    break;
+                case 'return_url':
+                case 'returnUrl':
+                    $this->returnUrl = $value === null ? null : (string) $value;
+                    break;
+                case 'customer_id':
+                case 'customerId':
+                    $this->customerId = $value === null ? null : (string) $value;
+                    break;
+                case 'purpose':
+                    $this->purpose = $value instanceof CheckoutCreateRequestPurpose || $value === null ? $value : CheckoutCreateRequestPurpose::from($value);
+                    break;
+                case 'valid_until':
+                case 'validUntil':
+                    $this->validUntil = $value === null ? null : (string) $value;
+                    break;
+                case 'redirect_url':
+                case 'redirectUrl':
+                    $this->redirectUrl = $value === null ? null : (string) $value;
+                    break;
+                case 'hosted_checkout':
+                case 'hostedCheckout':
+                    $this->hostedCheckout = $value instanceof HostedCheckout || $value === null ? $value : HostedCheckout::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->checkoutReference)) {
+            $data['checkout_reference'] = $this->checkoutReference;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency->value;
+        }
+        if (isset($this->merchantCode)) {
+            $data['merchant_code'] = $this->merchantCode;
+        }
+        if (isset($this->description)) {
+            $data['description'] = $this->description;
+        }
+        if (isset($this->returnUrl)) {
+            $data['return_url'] = $this->returnUrl;
+        }
+        if (isset($this->customerId)) {
+            $data['customer_id'] = $this->customerId;
+        }
+        if (isset($this->purpose) && ($this->purpose !== CheckoutCreateRequestPurpose::CHECKOUT || isset($this->explicitFields['purpose']))) {
+            $data['purpose'] = $this->purpose->value;
+        }
+        if (isset($this->validUntil)) {
+            $data['valid_until'] = $this->validUntil;
+        }
+        if (isset($this->redirectUrl)) {
+            $data['redirect_url'] = $this->redirectUrl;
+        }
+        if (isset($this->hostedCheckout)) {
+            $data['hosted_checkout'] = $this->hostedCheckout->toArray();
+        }
+
+        return $data + $this->additionalProperties();
+    }
+
+    /**
+     * @param array<string, mixed> $data
+     */
+    private function markExplicitFields(array $data): void
+    {
+        foreach ([
+            'purpose' => 'purpose',
+        ] as $serializedName => $propertyName) {
+            if (array_key_exists($serializedName, $data) || array_key_exists($propertyName, $data)) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
+    }
 }
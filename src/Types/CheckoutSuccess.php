@@ -7,106 +7,8 @@ namespace SumUp\Types;
 /**
  * Checkout resource returned after a synchronous processing attempt. In addition to the base checkout fields, it can include the resulting transaction identifiers and any newly created payment instrument token.
  */
-class CheckoutSuccess
+class CheckoutSuccess extends Checkout
 {
-    /**
-     * Merchant-defined reference for the checkout. Use it to correlate the SumUp checkout with your own order, cart, subscription, or payment attempt in your systems.
-     *
-     * @var string|null
-     */
-    public ?string $checkoutReference = null;
-
-    /**
-     * Amount to be charged to the payer, expressed in major units.
-     *
-     * @var float|null
-     */
-    public ?float $amount = null;
-
-    /**
-     * Three-letter [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) currency code of the amount.
-     *
-     * @var string|null
-     */
-    public ?string $currency = null;
-
-    /**
-     * Short unique identifier for the merchant that receives the payment.
-     *
-     * @var string|null
-     */
-    public ?string $merchantCode = null;
-
-    /**
-     * Short merchant-defined description shown in SumUp tools and reporting. Use it to make the checkout easier to recognize in dashboards, support workflows, and reconciliation.
-     *
-     * @var string|null
-     */
-    public ?string $description = null;
-
-    /**
-     * Optional backend callback URL used by SumUp to notify your platform about processing updates for the checkout.
-     *
-     * @var string|null
-     */
-    public ?string $returnUrl = null;
-
-    /**
-     * Unique SumUp identifier of the checkout resource.
-     *
-     * @var string|null
-     */
-    public ?string $id = null;
-
-    /**
-     * Current high-level state of the checkout. `PENDING` means the checkout exists but is not yet completed, `PAID` means a payment succeeded, `FAILED` means the latest processing attempt failed, and `EXPIRED` means the checkout can no longer be processed.
-     *
-     * @var string|null
-     */
-    public ?string $status = null;
-
-    /**
-     * The timestamp of when the checkout was created.
-     *
-     * @var string|null
-     */
-    public ?string $date = null;
-
-    /**
-     * Optional expiration timestamp. The checkout must be processed before this moment, otherwise it becomes unusable. If omitted, the checkout does not have an explicit expiry time.
-     *
-     * @var string|null
-     */
-    public ?string $validUntil = null;
-
-    /**
-     * Merchant-scoped identifier of the customer associated with the checkout. Use it when storing payment instruments or reusing saved customer context for recurring and returning-payer flows.
-     *
-     * @var string|null
-     */
-    public ?string $customerId = null;
-
-    /**
-     * Details of the mandate linked to the saved payment instrument.
-     *
-     * @var MandateResponse|null
-     */
-    public ?MandateResponse $mandate = null;
-
-    /**
-     * URL of the SumUp-hosted payment page that handles the payment flow. Returned when Hosted Checkout is enabled for the checkout.
-     *
-     * @var string|null
-     */
-    public ?string $hostedCheckoutUrl = null;
-
-    /**
-     * Payment attempts and resulting transaction records linked to this checkout. Use the Transactions endpoints when you need the authoritative payment result and event history.
-     *
-     * @var mixed[]|null
-     */
-    public ?array $transactions = null;
-
     /**
      * Transaction code of the successful transaction with which the payment for the checkout is completed.
      *
@@ -142,4 +44,173 @@ class CheckoutSuccess
      */
     public ?CheckoutSuccessPaymentInstrument $paymentInstrument = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'checkout_reference':
+                case 'checkoutReference':
+                    $this->checkoutReference = $value === null ? null : (string) $value;
+                    break;
+                case 'amount':
+                    $this->amount = $value === null ? null : (float) $value;
+                    break;
+                case 'currency':
+                    $this->currency = $value instanceof CheckoutCurrency || $value === null ? $value : CheckoutCurrency::from($value);
+                    break;
+                case 'merchant_code':
+                case 'merchantCode':
+                    $this->merchantCode = $value === null ? null : (string) $value;
+                    break;
+                case 'description':
+                    $this->description = $value === null ? null : (string) $value;
+                    break;
+                case 'return_url':
+                case 'returnUrl':
+                    $this->returnUrl = $value === null ? null : (string) $value;
+                    break;
+                case 'id':
+                    $this->id = $value === null ? null : (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value instanceof CheckoutStatus || $value === null ? $value : CheckoutStatus::from($value);
+                    break;
+                case 'date':
+                    $this->date = $value === null ? null : (string) $value;
+                    break;
+                case 'valid_until':
+                case 'validUntil':
+                    $this->validUntil = $value === null ? null : (string) $value;
+                    break;
+                case 'customer_id':
+                case 'customerId':
+                    $this->customerId = $value === null ? null : (string) $value;
+                    break;
+                case 'mandate':
+                    $this->mandate = $value instanceof MandateResponse || $value === null ? $value : MandateResponse::fromArray($value);
+                    break;
+                case 'hosted_checkout_url':
+                case 'hostedCheckoutUrl':
+                    $this->hostedCheckoutUrl = $value === null ? null : (string) $value;
+                    break;
+                case 'transactions':
+                    $this->transactions = $value === null ? null : (array) $value;
+                    break;
+                case 'transaction_code':
+                case 'transactionCode':
+                    $this->transactionCode = $value === null ? null : (string) $value;
+                    break;
+                case 'transaction_id':
+                case 'transactionId':
+                    $this->transactionId = $value === null ? null : (string) $value;
+                    break;
+                case 'merchant_name':
+                case 'merchantName':
+                    $this->merchantName = $value === null ? null : (string) $value;
+                    break;
+                case 'redirect_url':
+                case 'redirectUrl':
+                    $this->redirectUrl = $value === null ? null : (string) $value;
+                    break;
+                case 'payment_instrument':
+                case 'paymentInstrument':
+                    $this->paymentInstrument = $value instanceof CheckoutSuccessPaymentInstrument || $value === null ? $value : CheckoutSuccessPaymentInstrument::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->checkoutReference)) {
+            $data['checkout_reference'] = $this->checkoutReference;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency->value;
+        }
+        if (isset($this->merchantCode)) {
+            $data['merchant_code'] = $this->merchantCode;
+        }
+        if (isset($this->description)) {
+            $data['description'] = $this->description;
+        }
+        if (isset($this->returnUrl)) {
+            $data['return_url'] = $this->returnUrl;
+        }
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->date)) {
+            $data['date'] = $this->date;
+        }
+        if (isset($this->validUntil)) {
+            $data['valid_until'] = $this->validUntil;
+        }
+        if (isset($this->customerId)) {
+            $data['customer_id'] = $this->customerId;
+        }
+        if (isset($this->mandate)) {
+            $data['mandate'] = $this->mandate->toArray();
+        }
+        if (isset($this->hostedCheckoutUrl)) {
+            $data['hosted_checkout_url'] = $this->hostedCheckoutUrl;
+        }
+        if (isset($this->transactions)) {
+            $data['transactions'] = $this->transactions;
+        }
+        if (isset($this->transactionCode)) {
+            $data['transaction_code'] = $this->transactionCode;
+        }
+        if (isset($this->transactionId)) {
+            $data['transaction_id'] = $this->transactionId;
+        }
+        if (isset($this->merchantName)) {
+            $data['merchant_name'] = $this->merchantName;
+        }
+        if (isset($this->redirectUrl)) {
+            $data['redirect_url'] = $this->redirectUrl;
+        }
+        if (isset($this->paymentInstrument)) {
+            $data['payment_instrument'] = $this->paymentInstrument->toArray();
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
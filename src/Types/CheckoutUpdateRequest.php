@@ -4,11 +4,17 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+use SumUp\ExplicitFieldsInterface;
+
 /**
  * Request body for updating an existing checkout. Include only the fields that should be changed.
  */
-class CheckoutUpdateRequest
+class CheckoutUpdateRequest implements AdditionalPropertiesInterface, ExplicitFieldsInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Updated amount to be charged to the payer, expressed in major units.
      *
@@ -51,9 +57,19 @@ class CheckoutUpdateRequest
      */
     public ?string $customerId = null;
 
+    /**
+     * Properties explicitly set through fromArray(), setters, or the constructor with a non-null value.
+     *
+     * @var array<string, true>
+     */
+    private array $explicitFields = [];
+
     /**
      * Create request DTO.
      *
+     * Null arguments are left out of the payload. Send an explicit null
+     * through a setter or fromArray() to clear a field.
+     *
      * @param float|null $amount
      * @param CheckoutUpdateRequestCurrency|string|null $currency
      * @param string|null $description
@@ -69,14 +85,27 @@ class CheckoutUpdateRequest
         ?string $validUntil = null,
         ?string $customerId = null
     ) {
-        \SumUp\Hydrator::hydrate([
+        $this->fill([
             'amount' => $amount,
             'currency' => $currency,
             'description' => $description,
             'checkout_reference' => $checkoutReference,
             'valid_until' => $validUntil,
             'customer_id' => $customerId,
-        ], self::class, $this);
+        ]);
+
+        foreach ([
+            'amount' => $amount,
+            'currency' => $currency,
+            'description' => $description,
+            'checkoutReference' => $checkoutReference,
+            'validUntil' => $validUntil,
+            'customerId' => $customerId,
+        ] as $propertyName => $value) {
+            if ($value !== null) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
     }
 
     /**
@@ -86,10 +115,200 @@ class CheckoutUpdateRequest
      */
     public static function fromArray(array $data): self
     {
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self();
+        $request->fill($data);
+        $request->markExplicitFields($data);
 
         return $request;
     }
 
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'amount':
+                    $this->amount = $value === null ? null : (float) $value;
+                    break;
+                case 'currency':
+                    $this->currency = $value instanceof CheckoutUpdateRequestCurrency || $value === null ? $value : CheckoutUpdateRequestCurrency::from($value);
+                    break;
+                case 'description':
+                    $this->description = $value === null ? null : (string) $value;
+                    break;
+                case 'checkout_reference':
+                case 'checkoutReference':
+                    $this->checkoutReference = $value === null ? null : (string) $value;
+                    break;
+                case 'valid_until':
+                case 'validUntil':
+                    $this->validUntil = $value === null ? null : (string) $value;
+                    break;
+                case 'customer_id':
+                case 'customerId':
+                    $this->customerId = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        } elseif (isset($this->explicitFields['amount'])) {
+            $data['amount'] = null;
+        }
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency->value;
+        } elseif (isset($this->explicitFields['currency'])) {
+            $data['currency'] = null;
+        }
+        if (isset($this->description)) {
+            $data['description'] = $this->description;
+        } elseif (isset($this->explicitFields['description'])) {
+            $data['description'] = null;
+        }
+        if (isset($this->checkoutReference)) {
+            $data['checkout_reference'] = $this->checkoutReference;
+        } elseif (isset($this->explicitFields['checkoutReference'])) {
+            $data['checkout_reference'] = null;
+        }
+        if (isset($this->validUntil)) {
+            $data['valid_until'] = $this->validUntil;
+        } elseif (isset($this->explicitFields['validUntil'])) {
+            $data['valid_until'] = null;
+        }
+        if (isset($this->customerId)) {
+            $data['customer_id'] = $this->customerId;
+        } elseif (isset($this->explicitFields['customerId'])) {
+            $data['customer_id'] = null;
+        }
+
+        return $data + $this->additionalProperties();
+    }
+
+    /**
+     * Set amount, sending an explicit null when the value is null.
+     *
+     * @param float|null $amount
+     */
+    public function setAmount(?float $amount): self
+    {
+        $this->fill(['amount' => $amount]);
+        $this->explicitFields['amount'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set currency, sending an explicit null when the value is null.
+     *
+     * @param CheckoutUpdateRequestCurrency|string|null $currency
+     */
+    public function setCurrency(CheckoutUpdateRequestCurrency|string|null $currency): self
+    {
+        $this->fill(['currency' => $currency]);
+        $this->explicitFields['currency'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set description, sending an explicit null when the value is null.
+     *
+     * @param string|null $description
+     */
+    public function setDescription(?string $description): self
+    {
+        $this->fill(['description' => $description]);
+        $this->explicitFields['description'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set checkoutReference, sending an explicit null when the value is null.
+     *
+     * @param string|null $checkoutReference
+     */
+    public function setCheckoutReference(?string $checkoutReference): self
+    {
+        $this->fill(['checkout_reference' => $checkoutReference]);
+        $this->explicitFields['checkoutReference'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set validUntil, sending an explicit null when the value is null.
+     *
+     * @param string|null $validUntil
+     */
+    public function setValidUntil(?string $validUntil): self
+    {
+        $this->fill(['valid_until' => $validUntil]);
+        $this->explicitFields['validUntil'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Set customerId, sending an explicit null when the value is null.
+     *
+     * @param string|null $customerId
+     */
+    public function setCustomerId(?string $customerId): self
+    {
+        $this->fill(['customer_id' => $customerId]);
+        $this->explicitFields['customerId'] = true;
+
+        return $this;
+    }
+
+    /**
+     * Names of the properties explicitly set, including those set to null.
+     *
+     * @return string[]
+     */
+    public function explicitFields(): array
+    {
+        return array_keys($this->explicitFields);
+    }
+
+    /**
+     * @param array<string, mixed> $data
+     */
+    private function markExplicitFields(array $data): void
+    {
+        foreach ([
+            'amount' => 'amount',
+            'currency' => 'currency',
+            'description' => 'description',
+            'checkout_reference' => 'checkoutReference',
+            'valid_until' => 'validUntil',
+            'customer_id' => 'customerId',
+        ] as $serializedName => $propertyName) {
+            if (array_key_exists($serializedName, $data) || array_key_exists($propertyName, $data)) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
+    }
 }
@@ -4,12 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Information about the company or business. This is legal information that is used for verification.
- *
  */
-class Company
+class Company implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * The company's legal name.
      *
@@ -75,4 +79,106 @@ class Company
      */
     public ?array $attributes = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'name':
+                    $this->name = $value === null ? null : (string) $value;
+                    break;
+                case 'merchant_category_code':
+                case 'merchantCategoryCode':
+                    $this->merchantCategoryCode = $value === null ? null : (string) $value;
+                    break;
+                case 'legal_type':
+                case 'legalType':
+                    $this->legalType = $value === null ? null : (string) $value;
+                    break;
+                case 'address':
+                    $this->address = $value instanceof Address || $value === null ? $value : Address::fromArray($value);
+                    break;
+                case 'trading_address':
+                case 'tradingAddress':
+                    $this->tradingAddress = $value instanceof Address || $value === null ? $value : Address::fromArray($value);
+                    break;
+                case 'identifiers':
+                    $this->identifiers = $value === null ? null : array_map(static fn ($item) => $item instanceof CompanyIdentifier ? $item : CompanyIdentifier::fromArray($item), (array) $value);
+                    break;
+                case 'phone_number':
+                case 'phoneNumber':
+                    $this->phoneNumber = $value === null ? null : (string) $value;
+                    break;
+                case 'website':
+                    $this->website = $value === null ? null : (string) $value;
+                    break;
+                case 'attributes':
+                    $this->attributes = $value === null ? null : (array) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->name)) {
+            $data['name'] = $this->name;
+        }
+        if (isset($this->merchantCategoryCode)) {
+            $data['merchant_category_code'] = $this->merchantCategoryCode;
+        }
+        if (isset($this->legalType)) {
+            $data['legal_type'] = $this->legalType;
+        }
+        if (isset($this->address)) {
+            $data['address'] = $this->address->toArray();
+        }
+        if (isset($this->tradingAddress)) {
+            $data['trading_address'] = $this->tradingAddress->toArray();
+        }
+        if (isset($this->identifiers)) {
+            $data['identifiers'] = array_map(static fn (CompanyIdentifier $item) => $item->toArray(), $this->identifiers);
+        }
+        if (isset($this->phoneNumber)) {
+            $data['phone_number'] = $this->phoneNumber;
+        }
+        if (isset($this->website)) {
+            $data['website'] = $this->website;
+        }
+        if (isset($this->attributes)) {
+            $data['attributes'] = $this->attributes;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
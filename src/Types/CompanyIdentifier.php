@@ -4,8 +4,13 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class CompanyIdentifier
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
+class CompanyIdentifier implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * The unique reference for the company identifier type as defined in the country SDK.
      *
@@ -20,4 +25,60 @@ class CompanyIdentifier
      */
     public string $value;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'ref':
+                    $this->ref = (string) $value;
+                    break;
+                case 'value':
+                    $this->value = (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->ref)) {
+            $data['ref'] = $this->ref;
+        }
+        if (isset($this->value)) {
+            $data['value'] = $this->value;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Reader Checkout
  */
-class CreateReaderCheckoutRequest
+class CreateReaderCheckoutRequest implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Optional object containing data for transactions from ERP integrators in Greece that comply with the AADE 1155 protocol.
      * When such regulatory/business requirements apply, this object must be provided and contains the data needed to validate the transaction with the AADE signature provider.
@@ -44,6 +49,7 @@ class CreateReaderCheckoutRequest
      * Number of installments for the transaction.
      * It may vary according to the merchant country.
      * For example, in Brazil, the maximum number of installments is 12.
+     *
      * Omit if the merchant country does support installments.
      * Otherwise, the checkout will be rejected.
      *
@@ -71,22 +77,33 @@ class CreateReaderCheckoutRequest
     /**
      * Time in seconds the cardholder has to select a tip rate.
      * If not provided, the default value is 30 seconds.
+     *
      * It can only be set if `tip_rates` is provided.
+     *
      * **Note**: If the target device is a Solo, it must be in version 3.3.38.0 or higher.
      *
      * @var int|null
      */
-    public ?int $tipTimeout = null;
+    public ?int $tipTimeout = 30;
 
     /**
      * Amount structure.
+     *
      * The amount is represented as an integer value altogether with the currency and the minor unit.
+     *
      * For example, EUR 1.00 is represented as value 100 with minor unit of 2.
      *
      * @var CreateReaderCheckoutRequestTotalAmount
      */
     public CreateReaderCheckoutRequestTotalAmount $totalAmount;
 
+    /**
+     * Properties explicitly set through fromArray().
+     *
+     * @var array<string, true>
+     */
+    private array $explicitFields = [];
+
     /**
      * Create request DTO.
      *
@@ -109,9 +126,9 @@ class CreateReaderCheckoutRequest
         ?int $installments = null,
         ?string $returnUrl = null,
         ?array $tipRates = null,
-        ?int $tipTimeout = null
+        ?int $tipTimeout = 30
     ) {
-        \SumUp\Hydrator::hydrate([
+        $this->fill([
             'total_amount' => $totalAmount,
             'aade' => $aade,
             'affiliate' => $affiliate,
@@ -121,7 +138,7 @@ class CreateReaderCheckoutRequest
             'return_url' => $returnUrl,
             'tip_rates' => $tipRates,
             'tip_timeout' => $tipTimeout,
-        ], self::class, $this);
+        ]);
     }
 
     /**
@@ -131,12 +148,15 @@ class CreateReaderCheckoutRequest
      */
     public static function fromArray(array $data): self
     {
-        self::assertRequiredFields($data, [
+        $required = self::requiredFields($data, [
             'total_amount' => 'totalAmount',
         ]);
 
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self(
+            $required['totalAmount'] instanceof CreateReaderCheckoutRequestTotalAmount ? $required['totalAmount'] : CreateReaderCheckoutRequestTotalAmount::fromArray($required['totalAmount'])
+        );
+        $request->fill($data);
+        $request->markExplicitFields($data);
 
         return $request;
     }
@@ -144,14 +164,127 @@ class CreateReaderCheckoutRequest
     /**
      * @param array<string, mixed> $data
      * @param array<string, string> $requiredFields
+     *
+     * @return array<string, mixed>
      */
-    private static function assertRequiredFields(array $data, array $requiredFields): void
+    private static function requiredFields(array $data, array $requiredFields): array
     {
+        $values = [];
         foreach ($requiredFields as $serializedName => $propertyName) {
-            if (!array_key_exists($serializedName, $data) && !array_key_exists($propertyName, $data)) {
+            if (array_key_exists($serializedName, $data)) {
+                $values[$propertyName] = $data[$serializedName];
+            } elseif (array_key_exists($propertyName, $data)) {
+                $values[$propertyName] = $data[$propertyName];
+            } else {
                 throw new \InvalidArgumentException(sprintf('Missing required field "%s".', $serializedName));
             }
         }
+
+        return $values;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'aade':
+                    $this->aade = $value instanceof CreateReaderCheckoutRequestAade || $value === null ? $value : CreateReaderCheckoutRequestAade::fromArray($value);
+                    break;
+                case 'affiliate':
+                    $this->affiliate = $value instanceof CreateReaderCheckoutRequestAffiliate || $value === null ? $value : CreateReaderCheckoutRequestAffiliate::fromArray($value);
+                    break;
+                case 'card_type':
+                case 'cardType':
+                    $this->cardType = $value instanceof CreateReaderCheckoutRequestCardType || $value === null ? $value : CreateReaderCheckoutRequestCardType::from($value);
+                    break;
+                case 'description':
+                    $this->description = $value === null ? null : (string) $value;
+                    break;
+                case 'installments':
+                    $this->installments = $value === null ? null : (int) $value;
+                    break;
+                case 'return_url':
+                case 'returnUrl':
+                    $this->returnUrl = $value === null ? null : (string) $value;
+                    break;
+                case 'tip_rates':
+                case 'tipRates':
+                    $this->tipRates = $value === null ? null : array_map(static fn ($item) => (float) $item, (array) $value);
+                    break;
+                case 'tip_timeout':
+                case 'tipTimeout':
+                    $this->tipTimeout = $value === null ? null : (int) $value;
+                    break;
+                case 'total_amount':
+                case 'totalAmount':
+                    $this->totalAmount = $value instanceof CreateReaderCheckoutRequestTotalAmount ? $value : CreateReaderCheckoutRequestTotalAmount::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->aade)) {
+            $data['aade'] = $this->aade->toArray();
+        }
+        if (isset($this->affiliate)) {
+            $data['affiliate'] = $this->affiliate->toArray();
+        }
+        if (isset($this->cardType)) {
+            $data['card_type'] = $this->cardType->value;
+        }
+        if (isset($this->description)) {
+            $data['description'] = $this->description;
+        }
+        if (isset($this->installments)) {
+            $data['installments'] = $this->installments;
+        }
+        if (isset($this->returnUrl)) {
+            $data['return_url'] = $this->returnUrl;
+        }
+        if (isset($this->tipRates)) {
+            $data['tip_rates'] = $this->tipRates;
+        }
+        if (isset($this->tipTimeout) && ($this->tipTimeout !== 30 || isset($this->explicitFields['tipTimeout']))) {
+            $data['tip_timeout'] = $this->tipTimeout;
+        }
+        if (isset($this->totalAmount)) {
+            $data['total_amount'] = $this->totalAmount->toArray();
+        }
+
+        return $data + $this->additionalProperties();
     }
 
+    /**
+     * @param array<string, mixed> $data
+     */
+    private function markExplicitFields(array $data): void
+    {
+        foreach ([
+            'tip_timeout' => 'tipTimeout',
+        ] as $serializedName => $propertyName) {
+            if (array_key_exists($serializedName, $data) || array_key_exists($propertyName, $data)) {
+                $this->explicitFields[$propertyName] = true;
+            }
+        }
+    }
 }
@@ -4,13 +4,17 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Optional object containing data for transactions from ERP integrators in Greece that comply with the AADE 1155 protocol.
  * When such regulatory/business requirements apply, this object must be provided and contains the data needed to validate the transaction with the AADE signature provider.
- *
  */
-class CreateReaderCheckoutRequestAade
+class CreateReaderCheckoutRequestAade implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * The identifier of the AADE signature provider.
      *
@@ -32,4 +36,68 @@ class CreateReaderCheckoutRequestAade
      */
     public string $signatureData;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'provider_id':
+                case 'providerId':
+                    $this->providerId = (string) $value;
+                    break;
+                case 'signature':
+                    $this->signature = (string) $value;
+                    break;
+                case 'signature_data':
+                case 'signatureData':
+                    $this->signatureData = (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->providerId)) {
+            $data['provider_id'] = $this->providerId;
+        }
+        if (isset($this->signature)) {
+            $data['signature'] = $this->signature;
+        }
+        if (isset($this->signatureData)) {
+            $data['signature_data'] = $this->signatureData;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
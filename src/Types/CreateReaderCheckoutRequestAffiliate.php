@@ -4,13 +4,17 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Affiliate metadata for the transaction.
  * It is a field that allow for integrators to track the source of the transaction.
- *
  */
-class CreateReaderCheckoutRequestAffiliate
+class CreateReaderCheckoutRequestAffiliate implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Application ID of the affiliate.
      * It is a unique identifier for the application and should be set by the integrator in the [Affiliate Keys](https://developer.sumup.com/affiliate-keys) page.
@@ -44,4 +48,74 @@ class CreateReaderCheckoutRequestAffiliate
      */
     public ?array $tags = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'app_id':
+                case 'appId':
+                    $this->appId = (string) $value;
+                    break;
+                case 'foreign_transaction_id':
+                case 'foreignTransactionId':
+                    $this->foreignTransactionId = (string) $value;
+                    break;
+                case 'key':
+                    $this->key = (string) $value;
+                    break;
+                case 'tags':
+                    $this->tags = $value === null ? null : (array) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->appId)) {
+            $data['app_id'] = $this->appId;
+        }
+        if (isset($this->foreignTransactionId)) {
+            $data['foreign_transaction_id'] = $this->foreignTransactionId;
+        }
+        if (isset($this->key)) {
+            $data['key'] = $this->key;
+        }
+        if (isset($this->tags)) {
+            $data['tags'] = $this->tags;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
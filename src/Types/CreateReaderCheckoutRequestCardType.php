@@ -7,7 +7,6 @@ namespace SumUp\Types;
 /**
  * The card type of the card used for the transaction.
  * Is is required only for some countries (e.g: Brazil).
- *
  */
 enum CreateReaderCheckoutRequestCardType: string
 {
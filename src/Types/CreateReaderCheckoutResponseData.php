@@ -4,8 +4,13 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class CreateReaderCheckoutResponseData
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
+class CreateReaderCheckoutResponseData implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * The checkout ID is a unique identifier for the checkout.
      *
@@ -15,10 +20,69 @@ class CreateReaderCheckoutResponseData
 
     /**
      * The client transaction ID is a unique identifier for the transaction that is generated for the client.
+     *
      * It can be used later to fetch the transaction details via the [Transactions API](https://developer.sumup.com/api/transactions/get).
      *
      * @var string
      */
     public string $clientTransactionId;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'checkout_id':
+                case 'checkoutId':
+                    $this->checkoutId = $value === null ? null : (string) $value;
+                    break;
+                case 'client_transaction_id':
+                case 'clientTransactionId':
+                    $this->clientTransactionId = (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->checkoutId)) {
+            $data['checkout_id'] = $this->checkoutId;
+        }
+        if (isset($this->clientTransactionId)) {
+            $data['client_transaction_id'] = $this->clientTransactionId;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
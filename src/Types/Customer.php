@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Saved customer details.
  */
-class Customer
+class Customer implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique identifier of the customer.
      *
@@ -29,14 +34,12 @@ class Customer
      * @param string $customerId
      * @param PersonalDetails|null $personalDetails
      */
-    public function __construct(
-        string $customerId,
-        ?PersonalDetails $personalDetails = null
-    ) {
-        \SumUp\Hydrator::hydrate([
+    public function __construct(string $customerId, ?PersonalDetails $personalDetails = null)
+    {
+        $this->fill([
             'customer_id' => $customerId,
             'personal_details' => $personalDetails,
-        ], self::class, $this);
+        ]);
     }
 
     /**
@@ -46,12 +49,14 @@ class Customer
      */
     public static function fromArray(array $data): self
     {
-        self::assertRequiredFields($data, [
+        $required = self::requiredFields($data, [
             'customer_id' => 'customerId',
         ]);
 
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self(
+            (string) $required['customerId']
+        );
+        $request->fill($data);
 
         return $request;
     }
@@ -59,14 +64,68 @@ class Customer
     /**
      * @param array<string, mixed> $data
      * @param array<string, string> $requiredFields
+     *
+     * @return array<string, mixed>
      */
-    private static function assertRequiredFields(array $data, array $requiredFields): void
+    private static function requiredFields(array $data, array $requiredFields): array
     {
+        $values = [];
         foreach ($requiredFields as $serializedName => $propertyName) {
-            if (!array_key_exists($serializedName, $data) && !array_key_exists($propertyName, $data)) {
+            if (array_key_exists($serializedName, $data)) {
+                $values[$propertyName] = $data[$serializedName];
+            } elseif (array_key_exists($propertyName, $data)) {
+                $values[$propertyName] = $data[$propertyName];
+            } else {
                 throw new \InvalidArgumentException(sprintf('Missing required field "%s".', $serializedName));
             }
         }
+
+        return $values;
     }
 
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'customer_id':
+                case 'customerId':
+                    $this->customerId = (string) $value;
+                    break;
+                case 'personal_details':
+                case 'personalDetails':
+                    $this->personalDetails = $value instanceof PersonalDetails || $value === null ? $value : PersonalDetails::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->customerId)) {
+            $data['customer_id'] = $this->customerId;
+        }
+        if (isset($this->personalDetails)) {
+            $data['personal_details'] = $this->personalDetails->toArray();
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
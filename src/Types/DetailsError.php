@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Details of a request validation error.
  */
-class DetailsError
+class DetailsError implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Short title of the error.
      *
@@ -37,4 +42,73 @@ class DetailsError
      */
     public ?array $failedConstraints = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'title':
+                    $this->title = $value === null ? null : (string) $value;
+                    break;
+                case 'details':
+                    $this->details = $value === null ? null : (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value === null ? null : (float) $value;
+                    break;
+                case 'failed_constraints':
+                case 'failedConstraints':
+                    $this->failedConstraints = $value === null ? null : (array) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->title)) {
+            $data['title'] = $this->title;
+        }
+        if (isset($this->details)) {
+            $data['details'] = $this->details;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status;
+        }
+        if (isset($this->failedConstraints)) {
+            $data['failed_constraints'] = $this->failedConstraints;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
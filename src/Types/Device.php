@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Details of the device used to create the transaction.
  */
-class Device
+class Device implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Device name.
      *
@@ -44,4 +49,80 @@ class Device
      */
     public ?string $uuid = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'name':
+                    $this->name = $value === null ? null : (string) $value;
+                    break;
+                case 'system_name':
+                case 'systemName':
+                    $this->systemName = $value === null ? null : (string) $value;
+                    break;
+                case 'model':
+                    $this->model = $value === null ? null : (string) $value;
+                    break;
+                case 'system_version':
+                case 'systemVersion':
+                    $this->systemVersion = $value === null ? null : (string) $value;
+                    break;
+                case 'uuid':
+                    $this->uuid = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->name)) {
+            $data['name'] = $this->name;
+        }
+        if (isset($this->systemName)) {
+            $data['system_name'] = $this->systemName;
+        }
+        if (isset($this->model)) {
+            $data['model'] = $this->model;
+        }
+        if (isset($this->systemVersion)) {
+            $data['system_version'] = $this->systemVersion;
+        }
+        if (isset($this->uuid)) {
+            $data['uuid'] = $this->uuid;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
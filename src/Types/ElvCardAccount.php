@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Details of the ELV card account associated with the transaction.
  */
-class ElvCardAccount
+class ElvCardAccount implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * ELV card sort code.
      *
@@ -37,4 +42,75 @@ class ElvCardAccount
      */
     public ?string $iban = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'sort_code':
+                case 'sortCode':
+                    $this->sortCode = $value === null ? null : (string) $value;
+                    break;
+                case 'last_4_digits':
+                case 'last4Digits':
+                    $this->last4Digits = $value === null ? null : (string) $value;
+                    break;
+                case 'sequence_no':
+                case 'sequenceNo':
+                    $this->sequenceNo = $value === null ? null : (int) $value;
+                    break;
+                case 'iban':
+                    $this->iban = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->sortCode)) {
+            $data['sort_code'] = $this->sortCode;
+        }
+        if (isset($this->last4Digits)) {
+            $data['last_4_digits'] = $this->last4Digits;
+        }
+        if (isset($this->sequenceNo)) {
+            $data['sequence_no'] = $this->sequenceNo;
+        }
+        if (isset($this->iban)) {
+            $data['iban'] = $this->iban;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -7,27 +7,76 @@ namespace SumUp\Types;
 /**
  * Error payload with the invalid parameter reference.
  */
-class ErrorExtended
+class ErrorExtended extends Error
 {
     /**
-     * Short description of the error.
+     * Parameter name (with relative location) to which the error applies. Parameters from embedded resources are displayed using dot notation. For example, `card.name` refers to the `name` parameter embedded in the `card` object.
      *
      * @var string|null
      */
-    public ?string $message = null;
+    public ?string $param = null;
 
     /**
-     * Platform code for the error.
+     * Create an instance from an associative array payload.
      *
-     * @var string|null
+     * @param array<string, mixed> $data
      */
-    public ?string $errorCode = null;
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
 
     /**
-     * Parameter name (with relative location) to which the error applies. Parameters from embedded resources are displayed using dot notation. For example, `card.name` refers to the `name` parameter embedded in the `card` object.
+     * Assign the known fields of a payload, keeping the others as additional properties.
      *
-     * @var string|null
+     * @param array<string, mixed> $data
      */
-    public ?string $param = null;
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'message':
+                    $this->message = $value === null ? null : (string) $value;
+                    break;
+                case 'error_code':
+                case 'errorCode':
+                    $this->errorCode = $value === null ? null : (string) $value;
+                    break;
+                case 'param':
+                    $this->param = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->message)) {
+            $data['message'] = $this->message;
+        }
+        if (isset($this->errorCode)) {
+            $data['error_code'] = $this->errorCode;
+        }
+        if (isset($this->param)) {
+            $data['param'] = $this->param;
+        }
 
+        return $data + $this->additionalProperties();
+    }
 }
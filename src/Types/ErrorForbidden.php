@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Details of an error returned for a forbidden request.
  */
-class ErrorForbidden
+class ErrorForbidden implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Short description of the error.
      *
@@ -30,4 +35,69 @@ class ErrorForbidden
      */
     public ?string $statusCode = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'error_message':
+                case 'errorMessage':
+                    $this->errorMessage = $value === null ? null : (string) $value;
+                    break;
+                case 'error_code':
+                case 'errorCode':
+                    $this->errorCode = $value === null ? null : (string) $value;
+                    break;
+                case 'status_code':
+                case 'statusCode':
+                    $this->statusCode = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->errorMessage)) {
+            $data['error_message'] = $this->errorMessage;
+        }
+        if (isset($this->errorCode)) {
+            $data['error_code'] = $this->errorCode;
+        }
+        if (isset($this->statusCode)) {
+            $data['status_code'] = $this->statusCode;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
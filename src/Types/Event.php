@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * High-level transaction event details.
  */
-class Event
+class Event implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique identifier of the transaction event.
      *
@@ -32,7 +37,9 @@ class Event
 
     /**
      * Status of the transaction event.
+     *
      * Not every value is used for every event type.
+     *
      * - `PENDING`: The event has been created but is not final yet. Used for events that are still being processed and whose final outcome is not known yet.
      * - `SCHEDULED`: The event is planned for a future payout cycle but has not been executed yet. This applies to payout events before money is actually sent out.
      * - `RECONCILED`: The underlying payment has been matched with settlement data and is ready to continue through payout processing, but the funds have not been paid out yet. This applies to payout events.
@@ -87,4 +94,113 @@ class Event
      */
     public ?float $deductedFeeAmount = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = $value === null ? null : (int) $value;
+                    break;
+                case 'transaction_id':
+                case 'transactionId':
+                    $this->transactionId = $value === null ? null : (string) $value;
+                    break;
+                case 'type':
+                    $this->type = $value instanceof EventType || $value === null ? $value : EventType::from($value);
+                    break;
+                case 'status':
+                    $this->status = $value instanceof EventStatus || $value === null ? $value : EventStatus::from($value);
+                    break;
+                case 'amount':
+                    $this->amount = $value === null ? null : (float) $value;
+                    break;
+                case 'timestamp':
+                    $this->timestamp = $value === null ? null : (string) $value;
+                    break;
+                case 'fee_amount':
+                case 'feeAmount':
+                    $this->feeAmount = $value === null ? null : (float) $value;
+                    break;
+                case 'installment_number':
+                case 'installmentNumber':
+                    $this->installmentNumber = $value === null ? null : (int) $value;
+                    break;
+                case 'deducted_amount':
+                case 'deductedAmount':
+                    $this->deductedAmount = $value === null ? null : (float) $value;
+                    break;
+                case 'deducted_fee_amount':
+                case 'deductedFeeAmount':
+                    $this->deductedFeeAmount = $value === null ? null : (float) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->transactionId)) {
+            $data['transaction_id'] = $this->transactionId;
+        }
+        if (isset($this->type)) {
+            $data['type'] = $this->type->value;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->timestamp)) {
+            $data['timestamp'] = $this->timestamp;
+        }
+        if (isset($this->feeAmount)) {
+            $data['fee_amount'] = $this->feeAmount;
+        }
+        if (isset($this->installmentNumber)) {
+            $data['installment_number'] = $this->installmentNumber;
+        }
+        if (isset($this->deductedAmount)) {
+            $data['deducted_amount'] = $this->deductedAmount;
+        }
+        if (isset($this->deductedFeeAmount)) {
+            $data['deducted_fee_amount'] = $this->deductedFeeAmount;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,6 +4,9 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * A single payout-related record.
  *
@@ -11,8 +14,10 @@ namespace SumUp\Types;
  * - an actual payout sent to the merchant (`type = PAYOUT`)
  * - a deduction applied against merchant funds for a refund, chargeback, direct debit return, or balance adjustment
  */
-class FinancialPayout
+class FinancialPayout implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique identifier of the payout-related record.
      *
@@ -76,4 +81,103 @@ class FinancialPayout
      */
     public string $transactionCode;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = (int) $value;
+                    break;
+                case 'type':
+                    $this->type = $value instanceof FinancialPayoutType ? $value : FinancialPayoutType::from($value);
+                    break;
+                case 'amount':
+                    $this->amount = (float) $value;
+                    break;
+                case 'date':
+                    $this->date = (string) $value;
+                    break;
+                case 'currency':
+                    $this->currency = (string) $value;
+                    break;
+                case 'fee':
+                    $this->fee = (float) $value;
+                    break;
+                case 'status':
+                    $this->status = $value instanceof FinancialPayoutStatus ? $value : FinancialPayoutStatus::from($value);
+                    break;
+                case 'reference':
+                    $this->reference = (string) $value;
+                    break;
+                case 'transaction_code':
+                case 'transactionCode':
+                    $this->transactionCode = (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->type)) {
+            $data['type'] = $this->type->value;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->date)) {
+            $data['date'] = $this->date;
+        }
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency;
+        }
+        if (isset($this->fee)) {
+            $data['fee'] = $this->fee;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->reference)) {
+            $data['reference'] = $this->reference;
+        }
+        if (isset($this->transactionCode)) {
+            $data['transaction_code'] = $this->transactionCode;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
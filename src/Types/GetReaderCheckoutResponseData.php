@@ -4,14 +4,19 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class GetReaderCheckoutResponseData
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
+class GetReaderCheckoutResponseData implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Type of the card. Required for some countries
      *
-     * @var GetReaderCheckoutResponseDataCardType
+     * @var GetReaderCheckoutResponseDataCardType|null
      */
-    public GetReaderCheckoutResponseDataCardType $cardType;
+    public ?GetReaderCheckoutResponseDataCardType $cardType;
 
     /**
      * Unique identifier for the checkout
@@ -37,9 +42,9 @@ class GetReaderCheckoutResponseData
     /**
      * Number of installments for the transaction. Required for some countries.
      *
-     * @var int
+     * @var int|null
      */
-    public int $installments;
+    public ?int $installments;
 
     /**
      * Payment failure reason
@@ -51,9 +56,9 @@ class GetReaderCheckoutResponseData
     /**
      * Payment status from payments v2 event
      *
-     * @var string
+     * @var string|null
      */
-    public string $paymentStatus;
+    public ?string $paymentStatus;
 
     /**
      * Type of the payment. Required for some countries
@@ -85,7 +90,9 @@ class GetReaderCheckoutResponseData
 
     /**
      * Amount structure.
+     *
      * The amount is represented as an integer value altogether with the currency and the minor unit.
+     *
      * For example, EUR 1.00 is represented as value 100 with minor unit of 2.
      *
      * @var GetReaderCheckoutResponseDataTotalAmount
@@ -102,8 +109,148 @@ class GetReaderCheckoutResponseData
     /**
      * Checkout expiration timestamp. After this time, the checkout will be automatically cancelled.
      *
-     * @var string
+     * @var string|null
+     */
+    public ?string $validUntil;
+
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'card_type':
+                case 'cardType':
+                    $this->cardType = $value instanceof GetReaderCheckoutResponseDataCardType || $value === null ? $value : GetReaderCheckoutResponseDataCardType::from($value);
+                    break;
+                case 'checkout_id':
+                case 'checkoutId':
+                    $this->checkoutId = (string) $value;
+                    break;
+                case 'client_transaction_id':
+                case 'clientTransactionId':
+                    $this->clientTransactionId = (string) $value;
+                    break;
+                case 'created_at':
+                case 'createdAt':
+                    $this->createdAt = (string) $value;
+                    break;
+                case 'installments':
+                    $this->installments = $value === null ? null : (int) $value;
+                    break;
+                case 'payment_failure_reason':
+                case 'paymentFailureReason':
+                    $this->paymentFailureReason = $value === null ? null : (string) $value;
+                    break;
+                case 'payment_status':
+                case 'paymentStatus':
+                    $this->paymentStatus = $value === null ? null : (string) $value;
+                    break;
+                case 'payment_type':
+                case 'paymentType':
+                    $this->paymentType = $value instanceof GetReaderCheckoutResponseDataPaymentType ? $value : GetReaderCheckoutResponseDataPaymentType::from($value);
+                    break;
+                case 'reader_firmware_version':
+                case 'readerFirmwareVersion':
+                    $this->readerFirmwareVersion = (string) $value;
+                    break;
+                case 'reader_serial_number':
+                case 'readerSerialNumber':
+                    $this->readerSerialNumber = (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value instanceof GetReaderCheckoutResponseDataStatus ? $value : GetReaderCheckoutResponseDataStatus::from($value);
+                    break;
+                case 'total_amount':
+                case 'totalAmount':
+                    $this->totalAmount = $value instanceof GetReaderCheckoutResponseDataTotalAmount ? $value : GetReaderCheckoutResponseDataTotalAmount::fromArray($value);
+                    break;
+                case 'updated_at':
+                case 'updatedAt':
+                    $this->updatedAt = (string) $value;
+                    break;
+                case 'valid_until':
+                case 'validUntil':
+                    $this->validUntil = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
      */
-    public string $validUntil;
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->cardType)) {
+            $data['card_type'] = $this->cardType->value;
+        }
+        if (isset($this->checkoutId)) {
+            $data['checkout_id'] = $this->checkoutId;
+        }
+        if (isset($this->clientTransactionId)) {
+            $data['client_transaction_id'] = $this->clientTransactionId;
+        }
+        if (isset($this->createdAt)) {
+            $data['created_at'] = $this->createdAt;
+        }
+        if (isset($this->installments)) {
+            $data['installments'] = $this->installments;
+        }
+        if (isset($this->paymentFailureReason)) {
+            $data['payment_failure_reason'] = $this->paymentFailureReason;
+        }
+        if (isset($this->paymentStatus)) {
+            $data['payment_status'] = $this->paymentStatus;
+        }
+        if (isset($this->paymentType)) {
+            $data['payment_type'] = $this->paymentType->value;
+        }
+        if (isset($this->readerFirmwareVersion)) {
+            $data['reader_firmware_version'] = $this->readerFirmwareVersion;
+        }
+        if (isset($this->readerSerialNumber)) {
+            $data['reader_serial_number'] = $this->readerSerialNumber;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->totalAmount)) {
+            $data['total_amount'] = $this->totalAmount->toArray();
+        }
+        if (isset($this->updatedAt)) {
+            $data['updated_at'] = $this->updatedAt;
+        }
+        if (isset($this->validUntil)) {
+            $data['valid_until'] = $this->validUntil;
+        }
 
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,16 +4,20 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Amount structure.
  *
  * The amount is represented as an integer value altogether with the currency and the minor unit.
  *
  * For example, EUR 1.00 is represented as value 100 with minor unit of 2.
- *
  */
-class GetReaderCheckoutResponseDataTotalAmount
+class GetReaderCheckoutResponseDataTotalAmount implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Currency ISO 4217 code
      *
@@ -36,4 +40,67 @@ class GetReaderCheckoutResponseDataTotalAmount
      */
     public int $value;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'currency':
+                    $this->currency = (string) $value;
+                    break;
+                case 'minor_unit':
+                case 'minorUnit':
+                    $this->minorUnit = (int) $value;
+                    break;
+                case 'value':
+                    $this->value = (int) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency;
+        }
+        if (isset($this->minorUnit)) {
+            $data['minor_unit'] = $this->minorUnit;
+        }
+        if (isset($this->value)) {
+            $data['value'] = $this->value;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
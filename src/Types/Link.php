@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Details of a link to a related resource.
  */
-class Link
+class Link implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Relation of the linked resource to the current resource.
      *
@@ -44,4 +49,80 @@ class Link
      */
     public ?float $maxAmount = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'rel':
+                    $this->rel = $value === null ? null : (string) $value;
+                    break;
+                case 'href':
+                    $this->href = $value === null ? null : (string) $value;
+                    break;
+                case 'type':
+                    $this->type = $value === null ? null : (string) $value;
+                    break;
+                case 'min_amount':
+                case 'minAmount':
+                    $this->minAmount = $value === null ? null : (float) $value;
+                    break;
+                case 'max_amount':
+                case 'maxAmount':
+                    $this->maxAmount = $value === null ? null : (float) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->rel)) {
+            $data['rel'] = $this->rel;
+        }
+        if (isset($this->href)) {
+            $data['href'] = $this->href;
+        }
+        if (isset($this->type)) {
+            $data['type'] = $this->type;
+        }
+        if (isset($this->minAmount)) {
+            $data['min_amount'] = $this->minAmount;
+        }
+        if (isset($this->maxAmount)) {
+            $data['max_amount'] = $this->maxAmount;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
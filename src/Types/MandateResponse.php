@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Details of the mandate linked to the saved payment instrument.
  */
-class MandateResponse
+class MandateResponse implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Type of mandate stored for the checkout or payment instrument.
      *
@@ -30,4 +35,67 @@ class MandateResponse
      */
     public ?string $merchantCode = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'type':
+                    $this->type = $value === null ? null : (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value instanceof MandateResponseStatus || $value === null ? $value : MandateResponseStatus::from($value);
+                    break;
+                case 'merchant_code':
+                case 'merchantCode':
+                    $this->merchantCode = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->type)) {
+            $data['type'] = $this->type;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->merchantCode)) {
+            $data['merchant_code'] = $this->merchantCode;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * A member is user within specific resource identified by resource id, resource type, and associated roles.
  */
-class Member
+class Member implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * ID of the member.
      *
@@ -27,6 +32,7 @@ class Member
      * User's permissions.
      *
      * @var string[]
+     * @deprecated Permissions include only legacy permissions, please use roles instead. Member access is based on roles within a given resource and the permissions these roles grant.
      */
     public array $permissions;
 
@@ -79,4 +85,110 @@ class Member
      */
     public ?array $attributes = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = (string) $value;
+                    break;
+                case 'roles':
+                    $this->roles = array_map(static fn ($item) => (string) $item, (array) $value);
+                    break;
+                case 'permissions':
+                    $this->permissions = array_map(static fn ($item) => (string) $item, (array) $value);
+                    break;
+                case 'created_at':
+                case 'createdAt':
+                    $this->createdAt = (string) $value;
+                    break;
+                case 'updated_at':
+                case 'updatedAt':
+                    $this->updatedAt = (string) $value;
+                    break;
+                case 'user':
+                    $this->user = $value instanceof MembershipUser || $value === null ? $value : MembershipUser::fromArray($value);
+                    break;
+                case 'invite':
+                    $this->invite = $value instanceof Invite || $value === null ? $value : Invite::fromArray($value);
+                    break;
+                case 'status':
+                    $this->status = $value instanceof MemberStatus ? $value : MemberStatus::from($value);
+                    break;
+                case 'metadata':
+                    $this->metadata = $value === null ? null : (array) $value;
+                    break;
+                case 'attributes':
+                    $this->attributes = $value === null ? null : (array) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->roles)) {
+            $data['roles'] = $this->roles;
+        }
+        if (isset($this->permissions)) {
+            $data['permissions'] = $this->permissions;
+        }
+        if (isset($this->createdAt)) {
+            $data['created_at'] = $this->createdAt;
+        }
+        if (isset($this->updatedAt)) {
+            $data['updated_at'] = $this->updatedAt;
+        }
+        if (isset($this->user)) {
+            $data['user'] = $this->user->toArray();
+        }
+        if (isset($this->invite)) {
+            $data['invite'] = $this->invite->toArray();
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->metadata)) {
+            $data['metadata'] = $this->metadata;
+        }
+        if (isset($this->attributes)) {
+            $data['attributes'] = $this->attributes;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
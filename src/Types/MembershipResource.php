@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Information about the resource the membership is in.
  */
-class MembershipResource
+class MembershipResource implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * ID of the resource the membership is in.
      *
@@ -61,4 +66,92 @@ class MembershipResource
      */
     public ?array $attributes = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = (string) $value;
+                    break;
+                case 'type':
+                    $this->type = (string) $value;
+                    break;
+                case 'name':
+                    $this->name = (string) $value;
+                    break;
+                case 'logo':
+                    $this->logo = $value === null ? null : (string) $value;
+                    break;
+                case 'created_at':
+                case 'createdAt':
+                    $this->createdAt = (string) $value;
+                    break;
+                case 'updated_at':
+                case 'updatedAt':
+                    $this->updatedAt = (string) $value;
+                    break;
+                case 'attributes':
+                    $this->attributes = $value === null ? null : (array) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->type)) {
+            $data['type'] = $this->type;
+        }
+        if (isset($this->name)) {
+            $data['name'] = $this->name;
+        }
+        if (isset($this->logo)) {
+            $data['logo'] = $this->logo;
+        }
+        if (isset($this->createdAt)) {
+            $data['created_at'] = $this->createdAt;
+        }
+        if (isset($this->updatedAt)) {
+            $data['updated_at'] = $this->updatedAt;
+        }
+        if (isset($this->attributes)) {
+            $data['attributes'] = $this->attributes;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
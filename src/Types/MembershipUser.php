@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Information about the user associated with the membership.
  */
-class MembershipUser
+class MembershipUser implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Identifier for the End-User (also called Subject).
      *
@@ -41,6 +46,7 @@ class MembershipUser
      * True if the user is a virtual user (operator).
      *
      * @var bool
+     * @deprecated Rely on `type` instead.
      */
     public bool $virtualUser;
 
@@ -48,6 +54,7 @@ class MembershipUser
      * True if the user is a service account.
      *
      * @var bool
+     * @deprecated Rely on `type` instead.
      */
     public bool $serviceAccountUser;
 
@@ -76,7 +83,116 @@ class MembershipUser
      * Classic identifiers of the user.
      *
      * @var MembershipUserClassic|null
+     * @deprecated
      */
     public ?MembershipUserClassic $classic = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = (string) $value;
+                    break;
+                case 'type':
+                    $this->type = $value instanceof MembershipUserType ? $value : MembershipUserType::from($value);
+                    break;
+                case 'email':
+                    $this->email = (string) $value;
+                    break;
+                case 'mfa_on_login_enabled':
+                case 'mfaOnLoginEnabled':
+                    $this->mfaOnLoginEnabled = (bool) $value;
+                    break;
+                case 'virtual_user':
+                case 'virtualUser':
+                    $this->virtualUser = (bool) $value;
+                    break;
+                case 'service_account_user':
+                case 'serviceAccountUser':
+                    $this->serviceAccountUser = (bool) $value;
+                    break;
+                case 'disabled_at':
+                case 'disabledAt':
+                    $this->disabledAt = $value === null ? null : (string) $value;
+                    break;
+                case 'nickname':
+                    $this->nickname = $value === null ? null : (string) $value;
+                    break;
+                case 'picture':
+                    $this->picture = $value === null ? null : (string) $value;
+                    break;
+                case 'classic':
+                    $this->classic = $value instanceof MembershipUserClassic || $value === null ? $value : MembershipUserClassic::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->type)) {
+            $data['type'] = $this->type->value;
+        }
+        if (isset($this->email)) {
+            $data['email'] = $this->email;
+        }
+        if (isset($this->mfaOnLoginEnabled)) {
+            $data['mfa_on_login_enabled'] = $this->mfaOnLoginEnabled;
+        }
+        if (isset($this->virtualUser)) {
+            $data['virtual_user'] = $this->virtualUser;
+        }
+        if (isset($this->serviceAccountUser)) {
+            $data['service_account_user'] = $this->serviceAccountUser;
+        }
+        if (isset($this->disabledAt)) {
+            $data['disabled_at'] = $this->disabledAt;
+        }
+        if (isset($this->nickname)) {
+            $data['nickname'] = $this->nickname;
+        }
+        if (isset($this->picture)) {
+            $data['picture'] = $this->picture;
+        }
+        if (isset($this->classic)) {
+            $data['classic'] = $this->classic->toArray();
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
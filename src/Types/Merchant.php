@@ -4,7 +4,7 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class Merchant
+class Merchant extends Timestamps
 {
     /**
      * Short unique identifier for the merchant.
@@ -93,14 +93,14 @@ class Merchant
 
     /**
      * A set of key-value pairs that you can attach to an object. This can be useful for storing additional information about the object in a structured format.
+     *
      * **Warning**: Updating Meta will overwrite the existing data. Make sure to always include the complete JSON object.
      *
-     * @var array<string, mixed>|null
+     * @var Meta|null
      */
-    public ?array $meta = null;
+    public ?Meta $meta = null;
 
     /**
-     *
      * @var ClassicMerchantIdentifiers|null
      */
     public ?ClassicMerchantIdentifiers $classic = null;
@@ -121,17 +121,158 @@ class Merchant
     public ?string $changeStatus = null;
 
     /**
-     * The date and time when the resource was created. This is a string as defined in [RFC 3339, section 5.6](https://datatracker.ietf.org/doc/html/rfc3339#section-5.6).
+     * Create an instance from an associative array payload.
      *
-     * @var string
+     * @param array<string, mixed> $data
      */
-    public string $createdAt;
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
 
     /**
-     * The date and time when the resource was last updated. This is a string as defined in [RFC 3339, section 5.6](https://datatracker.ietf.org/doc/html/rfc3339#section-5.6).
+     * Assign the known fields of a payload, keeping the others as additional properties.
      *
-     * @var string
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'merchant_code':
+                case 'merchantCode':
+                    $this->merchantCode = (string) $value;
+                    break;
+                case 'organization_id':
+                case 'organizationId':
+                    $this->organizationId = $value === null ? null : (string) $value;
+                    break;
+                case 'business_type':
+                case 'businessType':
+                    $this->businessType = $value === null ? null : (string) $value;
+                    break;
+                case 'company':
+                    $this->company = $value instanceof Company || $value === null ? $value : Company::fromArray($value);
+                    break;
+                case 'country':
+                    $this->country = (string) $value;
+                    break;
+                case 'business_profile':
+                case 'businessProfile':
+                    $this->businessProfile = $value instanceof BusinessProfile || $value === null ? $value : BusinessProfile::fromArray($value);
+                    break;
+                case 'avatar':
+                    $this->avatar = $value === null ? null : (string) $value;
+                    break;
+                case 'alias':
+                    $this->alias = $value === null ? null : (string) $value;
+                    break;
+                case 'default_currency':
+                case 'defaultCurrency':
+                    $this->defaultCurrency = (string) $value;
+                    break;
+                case 'default_locale':
+                case 'defaultLocale':
+                    $this->defaultLocale = (string) $value;
+                    break;
+                case 'sandbox':
+                    $this->sandbox = $value === null ? null : (bool) $value;
+                    break;
+                case 'meta':
+                    $this->meta = $value instanceof Meta || $value === null ? $value : Meta::fromArray($value);
+                    break;
+                case 'classic':
+                    $this->classic = $value instanceof ClassicMerchantIdentifiers || $value === null ? $value : ClassicMerchantIdentifiers::fromArray($value);
+                    break;
+                case 'version':
+                    $this->version = $value === null ? null : (string) $value;
+                    break;
+                case 'change_status':
+                case 'changeStatus':
+                    $this->changeStatus = $value === null ? null : (string) $value;
+                    break;
+                case 'created_at':
+                case 'createdAt':
+                    $this->createdAt = (string) $value;
+                    break;
+                case 'updated_at':
+                case 'updatedAt':
+                    $this->updatedAt = (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
      */
-    public string $updatedAt;
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->merchantCode)) {
+            $data['merchant_code'] = $this->merchantCode;
+        }
+        if (isset($this->organizationId)) {
+            $data['organization_id'] = $this->organizationId;
+        }
+        if (isset($this->businessType)) {
+            $data['business_type'] = $this->businessType;
+        }
+        if (isset($this->company)) {
+            $data['company'] = $this->company->toArray();
+        }
+        if (isset($this->country)) {
+            $data['country'] = $this->country;
+        }
+        if (isset($this->businessProfile)) {
+            $data['business_profile'] = $this->businessProfile->toArray();
+        }
+        if (isset($this->avatar)) {
+            $data['avatar'] = $this->avatar;
+        }
+        if (isset($this->alias)) {
+            $data['alias'] = $this->alias;
+        }
+        if (isset($this->defaultCurrency)) {
+            $data['default_currency'] = $this->defaultCurrency;
+        }
+        if (isset($this->defaultLocale)) {
+            $data['default_locale'] = $this->defaultLocale;
+        }
+        if (isset($this->sandbox)) {
+            $data['sandbox'] = $this->sandbox;
+        }
+        if (isset($this->meta)) {
+            $data['meta'] = $this->meta->toArray();
+        }
+        if (isset($this->classic)) {
+            $data['classic'] = $this->classic->toArray();
+        }
+        if (isset($this->version)) {
+            $data['version'] = $this->version;
+        }
+        if (isset($this->changeStatus)) {
+            $data['change_status'] = $this->changeStatus;
+        }
+        if (isset($this->createdAt)) {
+            $data['created_at'] = $this->createdAt;
+        }
+        if (isset($this->updatedAt)) {
+            $data['updated_at'] = $this->updatedAt;
+        }
 
+        return $data + $this->additionalProperties();
+    }
 }
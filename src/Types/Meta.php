@@ -0,0 +1,40 @@
+<?php
+
+declare(strict_types=1);
+
+namespace SumUp\Types;
+
+/**
+ * A set of key-value pairs that you can attach to an object. This can be useful for storing additional information about the object in a structured format.
+ *
+ * **Warning**: Updating Meta will overwrite the existing data. Make sure to always include the complete JSON object.
+ *
+ * @extends \ArrayObject<string, string>
+ */
+class Meta extends \ArrayObject
+{
+    /**
+     * Type each value of the map is hydrated into.
+     */
+    public const VALUE_TYPE = 'string';
+
+    /**
+     * Create a map from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        return new self(array_map(static fn ($item) => (string) $item, $data));
+    }
+
+    /**
+     * Convert the map into a payload.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        return $this->getArrayCopy();
+    }
+}
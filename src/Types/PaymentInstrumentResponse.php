@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Details of a saved payment instrument.
  */
-class PaymentInstrumentResponse
+class PaymentInstrumentResponse implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique token identifying the saved payment card for a customer.
      *
@@ -21,7 +26,7 @@ class PaymentInstrumentResponse
      *
      * @var bool|null
      */
-    public ?bool $active = null;
+    public ?bool $active = true;
 
     /**
      * Type of the payment instrument.
@@ -51,4 +56,85 @@ class PaymentInstrumentResponse
      */
     public ?string $createdAt = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'token':
+                    $this->token = $value === null ? null : (string) $value;
+                    break;
+                case 'active':
+                    $this->active = $value === null ? null : (bool) $value;
+                    break;
+                case 'type':
+                    $this->type = $value instanceof PaymentInstrumentResponseType || $value === null ? $value : PaymentInstrumentResponseType::from($value);
+                    break;
+                case 'card':
+                    $this->card = $value instanceof PaymentInstrumentResponseCard || $value === null ? $value : PaymentInstrumentResponseCard::fromArray($value);
+                    break;
+                case 'mandate':
+                    $this->mandate = $value instanceof MandateResponse || $value === null ? $value : MandateResponse::fromArray($value);
+                    break;
+                case 'created_at':
+                case 'createdAt':
+                    $this->createdAt = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->token)) {
+            $data['token'] = $this->token;
+        }
+        if (isset($this->active)) {
+            $data['active'] = $this->active;
+        }
+        if (isset($this->type)) {
+            $data['type'] = $this->type->value;
+        }
+        if (isset($this->card)) {
+            $data['card'] = $this->card->toArray();
+        }
+        if (isset($this->mandate)) {
+            $data['mandate'] = $this->mandate->toArray();
+        }
+        if (isset($this->createdAt)) {
+            $data['created_at'] = $this->createdAt;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Details of the payment card.
  */
-class PaymentInstrumentResponseCard
+class PaymentInstrumentResponseCard implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Last 4 digits of the payment card number.
      *
@@ -23,4 +28,61 @@ class PaymentInstrumentResponseCard
      */
     public ?PaymentInstrumentResponseCardType $type = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'last_4_digits':
+                case 'last4Digits':
+                    $this->last4Digits = $value === null ? null : (string) $value;
+                    break;
+                case 'type':
+                    $this->type = $value instanceof PaymentInstrumentResponseCardType || $value === null ? $value : PaymentInstrumentResponseCardType::from($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->last4Digits)) {
+            $data['last_4_digits'] = $this->last4Digits;
+        }
+        if (isset($this->type)) {
+            $data['type'] = $this->type->value;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,121 +4,153 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class Person
+class Person extends BasePerson
 {
     /**
-     * The unique identifier for the Person. This is a [typeid](https://github.com/sumup/typeid).
-     *
-     * @var string
-     */
-    public string $id;
-
-    /**
-     * A corresponding identity user ID for the Person, if they have a user account.
-     *
-     * @var string|null
-     */
-    public ?string $userId = null;
-
-    /**
-     * The date of birth of the individual, represented as an ISO 8601:2004 [ISO8601‑2004] YYYY-MM-DD format.
-     *
-     * @var string|null
-     */
-    public ?string $birthdate = null;
-
-    /**
-     * The first name(s) of the individual.
-     *
-     * @var string|null
-     */
-    public ?string $givenName = null;
-
-    /**
-     * The last name(s) of the individual.
-     *
-     * @var string|null
-     */
-    public ?string $familyName = null;
-
-    /**
-     * Middle name(s) of the End-User. Note that in some cultures, people can have multiple middle names; all can be present, with the names being separated by space characters. Also note that in some cultures, middle names are not used.
-     *
-     * @var string|null
-     */
-    public ?string $middleName = null;
-
-    /**
-     * A publicly available phone number in [E.164](https://en.wikipedia.org/wiki/E.164) format.
-     *
-     * @var string|null
-     */
-    public ?string $phoneNumber = null;
-
-    /**
-     * A list of roles the Person has in the Merchant or towards SumUp. A Merchant must have at least one Person with the relationship `representative`.
-     *
-     * @var string[]|null
-     */
-    public ?array $relationships = null;
-
-    /**
-     *
-     * @var Ownership|null
-     */
-    public ?Ownership $ownership = null;
-
-    /**
-     * An address somewhere in the world. The address fields used depend on the country conventions. For example, in Great Britain, `city` is `post_town`. In the United States, the top-level administrative unit used in addresses is `state`, whereas in Chile it's `region`.
-     * Whether an address is valid or not depends on whether the locally required fields are present. Fields not supported in a country will be ignored.
-     *
-     * @var Address|null
-     */
-    public ?Address $address = null;
-
-    /**
-     * A list of country-specific personal identifiers.
-     *
-     * @var PersonalIdentifier[]|null
-     */
-    public ?array $identifiers = null;
-
-    /**
-     * An [ISO3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2)
-     * country code. This definition users `oneOf` with a two-character string
-     * type to allow for support of future countries in client code.
-     *
-     * @var string|null
-     */
-    public ?string $citizenship = null;
-
-    /**
-     * The Person's nationality. May be an [ISO3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2) country code, but legacy data may not conform to this standard.
-     *
-     * @var string|null
-     */
-    public ?string $nationality = null;
-
-    /**
-     * An [ISO3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2) country code representing the country where the Person resides.
-     *
-     * @var string|null
-     */
-    public ?string $countryOfResidence = null;
-
-    /**
-     * The version of the resource. The version reflects a specific change submitted to the API via one of the `PATCH` endpoints.
-     *
-     * @var string|null
-     */
-    public ?string $version = null;
-
-    /**
-     * Reflects the status of changes submitted through the `PATCH` endpoints for the Merchant or Persons. If some changes have not been applied yet, the status will be `pending`. If all changes have been applied, the status `done`.
-     * The status is only returned after write operations or on read endpoints when the `version` query parameter is provided.
-     *
-     * @var string|null
-     */
-    public ?string $changeStatus = null;
-
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = (string) $value;
+                    break;
+                case 'user_id':
+                case 'userId':
+                    $this->userId = $value === null ? null : (string) $value;
+                    break;
+                case 'birthdate':
+                    $this->birthdate = $value === null ? null : (string) $value;
+                    break;
+                case 'given_name':
+                case 'givenName':
+                    $this->givenName = $value === null ? null : (string) $value;
+                    break;
+                case 'family_name':
+                case 'familyName':
+                    $this->familyName = $value === null ? null : (string) $value;
+                    break;
+                case 'middle_name':
+                case 'middleName':
+                    $this->middleName = $value === null ? null : (string) $value;
+                    break;
+                case 'phone_number':
+                case 'phoneNumber':
+                    $this->phoneNumber = $value === null ? null : (string) $value;
+                    break;
+                case 'relationships':
+                    $this->relationships = $value === null ? null : array_map(static fn ($item) => (string) $item, (array) $value);
+                    break;
+                case 'ownership':
+                    $this->ownership = $value instanceof Ownership || $value === null ? $value : Ownership::fromArray($value);
+                    break;
+                case 'address':
+                    $this->address = $value instanceof Address || $value === null ? $value : Address::fromArray($value);
+                    break;
+                case 'identifiers':
+                    $this->identifiers = $value === null ? null : array_map(static fn ($item) => $item instanceof PersonalIdentifier ? $item : PersonalIdentifier::fromArray($item), (array) $value);
+                    break;
+                case 'citizenship':
+                    $this->citizenship = $value === null ? null : (string) $value;
+                    break;
+                case 'nationality':
+                    $this->nationality = $value === null ? null : (string) $value;
+                    break;
+                case 'country_of_residence':
+                case 'countryOfResidence':
+                    $this->countryOfResidence = $value === null ? null : (string) $value;
+                    break;
+                case 'version':
+                    $this->version = $value === null ? null : (string) $value;
+                    break;
+                case 'change_status':
+                case 'changeStatus':
+                    $this->changeStatus = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->userId)) {
+            $data['user_id'] = $this->userId;
+        }
+        if (isset($this->birthdate)) {
+            $data['birthdate'] = $this->birthdate;
+        }
+        if (isset($this->givenName)) {
+            $data['given_name'] = $this->givenName;
+        }
+        if (isset($this->familyName)) {
+            $data['family_name'] = $this->familyName;
+        }
+        if (isset($this->middleName)) {
+            $data['middle_name'] = $this->middleName;
+        }
+        if (isset($this->phoneNumber)) {
+            $data['phone_number'] = $this->phoneNumber;
+        }
+        if (isset($this->relationships)) {
+            $data['relationships'] = $this->relationships;
+        }
+        if (isset($this->ownership)) {
+            $data['ownership'] = $this->ownership->toArray();
+        }
+        if (isset($this->address)) {
+            $data['address'] = $this->address->toArray();
+        }
+        if (isset($this->identifiers)) {
+            $data['identifiers'] = array_map(static fn (PersonalIdentifier $item) => $item->toArray(), $this->identifiers);
+        }
+        if (isset($this->citizenship)) {
+            $data['citizenship'] = $this->citizenship;
+        }
+        if (isset($this->nationality)) {
+            $data['nationality'] = $this->nationality;
+        }
+        if (isset($this->countryOfResidence)) {
+            $data['country_of_residence'] = $this->countryOfResidence;
+        }
+        if (isset($this->version)) {
+            $data['version'] = $this->version;
+        }
+        if (isset($this->changeStatus)) {
+            $data['change_status'] = $this->changeStatus;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
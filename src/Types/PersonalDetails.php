@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Personal details for the customer.
  */
-class PersonalDetails
+class PersonalDetails implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * First name of the customer.
      *
@@ -58,4 +63,94 @@ class PersonalDetails
      */
     public ?AddressLegacy $address = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'first_name':
+                case 'firstName':
+                    $this->firstName = $value === null ? null : (string) $value;
+                    break;
+                case 'last_name':
+                case 'lastName':
+                    $this->lastName = $value === null ? null : (string) $value;
+                    break;
+                case 'email':
+                    $this->email = $value === null ? null : (string) $value;
+                    break;
+                case 'phone':
+                    $this->phone = $value === null ? null : (string) $value;
+                    break;
+                case 'birth_date':
+                case 'birthDate':
+                    $this->birthDate = $value === null ? null : (string) $value;
+                    break;
+                case 'tax_id':
+                case 'taxId':
+                    $this->taxId = $value === null ? null : (string) $value;
+                    break;
+                case 'address':
+                    $this->address = $value instanceof AddressLegacy || $value === null ? $value : AddressLegacy::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->firstName)) {
+            $data['first_name'] = $this->firstName;
+        }
+        if (isset($this->lastName)) {
+            $data['last_name'] = $this->lastName;
+        }
+        if (isset($this->email)) {
+            $data['email'] = $this->email;
+        }
+        if (isset($this->phone)) {
+            $data['phone'] = $this->phone;
+        }
+        if (isset($this->birthDate)) {
+            $data['birth_date'] = $this->birthDate;
+        }
+        if (isset($this->taxId)) {
+            $data['tax_id'] = $this->taxId;
+        }
+        if (isset($this->address)) {
+            $data['address'] = $this->address->toArray();
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,13 +4,18 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * A RFC 9457 problem details object.
  *
  * Additional properties specific to the problem type may be present.
  */
-class Problem
+class Problem implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * A URI reference that identifies the problem type.
      *
@@ -46,4 +51,78 @@ class Problem
      */
     public ?string $instance = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'type':
+                    $this->type = (string) $value;
+                    break;
+                case 'title':
+                    $this->title = $value === null ? null : (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value === null ? null : (int) $value;
+                    break;
+                case 'detail':
+                    $this->detail = $value === null ? null : (string) $value;
+                    break;
+                case 'instance':
+                    $this->instance = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->type)) {
+            $data['type'] = $this->type;
+        }
+        if (isset($this->title)) {
+            $data['title'] = $this->title;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status;
+        }
+        if (isset($this->detail)) {
+            $data['detail'] = $this->detail;
+        }
+        if (isset($this->instance)) {
+            $data['instance'] = $this->instance;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
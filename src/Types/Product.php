@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Product details associated with a transaction.
  */
-class Product
+class Product implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Product name.
      *
@@ -79,4 +84,115 @@ class Product
      */
     public ?float $totalWithVat = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'name':
+                    $this->name = $value === null ? null : (string) $value;
+                    break;
+                case 'price_label':
+                case 'priceLabel':
+                    $this->priceLabel = $value === null ? null : (string) $value;
+                    break;
+                case 'price':
+                    $this->price = $value === null ? null : (float) $value;
+                    break;
+                case 'vat_rate':
+                case 'vatRate':
+                    $this->vatRate = $value === null ? null : (float) $value;
+                    break;
+                case 'single_vat_amount':
+                case 'singleVatAmount':
+                    $this->singleVatAmount = $value === null ? null : (float) $value;
+                    break;
+                case 'price_with_vat':
+                case 'priceWithVat':
+                    $this->priceWithVat = $value === null ? null : (float) $value;
+                    break;
+                case 'vat_amount':
+                case 'vatAmount':
+                    $this->vatAmount = $value === null ? null : (float) $value;
+                    break;
+                case 'quantity':
+                    $this->quantity = $value === null ? null : (int) $value;
+                    break;
+                case 'total_price':
+                case 'totalPrice':
+                    $this->totalPrice = $value === null ? null : (float) $value;
+                    break;
+                case 'total_with_vat':
+                case 'totalWithVat':
+                    $this->totalWithVat = $value === null ? null : (float) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->name)) {
+            $data['name'] = $this->name;
+        }
+        if (isset($this->priceLabel)) {
+            $data['price_label'] = $this->priceLabel;
+        }
+        if (isset($this->price)) {
+            $data['price'] = $this->price;
+        }
+        if (isset($this->vatRate)) {
+            $data['vat_rate'] = $this->vatRate;
+        }
+        if (isset($this->singleVatAmount)) {
+            $data['single_vat_amount'] = $this->singleVatAmount;
+        }
+        if (isset($this->priceWithVat)) {
+            $data['price_with_vat'] = $this->priceWithVat;
+        }
+        if (isset($this->vatAmount)) {
+            $data['vat_amount'] = $this->vatAmount;
+        }
+        if (isset($this->quantity)) {
+            $data['quantity'] = $this->quantity;
+        }
+        if (isset($this->totalPrice)) {
+            $data['total_price'] = $this->totalPrice;
+        }
+        if (isset($this->totalWithVat)) {
+            $data['total_with_vat'] = $this->totalWithVat;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * A physical card reader device that can accept in-person payments.
  */
-class Reader
+class Reader implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique identifier of the reader that the payment is initiated on.
      *
@@ -25,7 +30,9 @@ class Reader
 
     /**
      * The status of the reader object gives information about the current state of the reader.
+     *
      * Possible values:
+     *
      * - `unknown` - The reader status is unknown.
      * - `processing` - The reader is created and waits for the physical device to confirm the pairing.
      * - `paired` - The reader is paired with a merchant account and can be used with SumUp APIs.
@@ -55,6 +62,7 @@ class Reader
      * This field is currently in beta and may change.
      *
      * @var string|null
+     * @experimental
      */
     public ?string $serviceAccountId = null;
 
@@ -72,4 +80,99 @@ class Reader
      */
     public string $updatedAt;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = (string) $value;
+                    break;
+                case 'name':
+                    $this->name = (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value instanceof ReaderStatus ? $value : ReaderStatus::from($value);
+                    break;
+                case 'device':
+                    $this->device = $value instanceof ReaderDevice ? $value : ReaderDevice::fromArray($value);
+                    break;
+                case 'metadata':
+                    $this->metadata = $value === null ? null : (array) $value;
+                    break;
+                case 'service_account_id':
+                case 'serviceAccountId':
+                    $this->serviceAccountId = $value === null ? null : (string) $value;
+                    break;
+                case 'created_at':
+                case 'createdAt':
+                    $this->createdAt = (string) $value;
+                    break;
+                case 'updated_at':
+                case 'updatedAt':
+                    $this->updatedAt = (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->name)) {
+            $data['name'] = $this->name;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->device)) {
+            $data['device'] = $this->device->toArray();
+        }
+        if (isset($this->metadata)) {
+            $data['metadata'] = $this->metadata;
+        }
+        if (isset($this->serviceAccountId)) {
+            $data['service_account_id'] = $this->serviceAccountId;
+        }
+        if (isset($this->createdAt)) {
+            $data['created_at'] = $this->createdAt;
+        }
+        if (isset($this->updatedAt)) {
+            $data['updated_at'] = $this->updatedAt;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
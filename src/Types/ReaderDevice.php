@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Information about the underlying physical device.
  */
-class ReaderDevice
+class ReaderDevice implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * A unique identifier of the physical device (e.g. serial number).
      *
@@ -23,4 +28,60 @@ class ReaderDevice
      */
     public ReaderDeviceModel $model;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'identifier':
+                    $this->identifier = (string) $value;
+                    break;
+                case 'model':
+                    $this->model = $value instanceof ReaderDeviceModel ? $value : ReaderDeviceModel::from($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->identifier)) {
+            $data['identifier'] = $this->identifier;
+        }
+        if (isset($this->model)) {
+            $data['model'] = $this->model->value;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
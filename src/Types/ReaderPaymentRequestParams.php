@@ -4,10 +4,14 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class ReaderPaymentRequestParams
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
+class ReaderPaymentRequestParams implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
-     *
      * @var Affiliate|null
      */
     public ?Affiliate $affiliate = null;
@@ -27,7 +31,6 @@ class ReaderPaymentRequestParams
     public ?int $tipAmount = null;
 
     /**
-     *
      * @var Amount
      */
     public Amount $totalAmount;
@@ -46,12 +49,12 @@ class ReaderPaymentRequestParams
         ?Affiliate $affiliate = null,
         ?int $tipAmount = null
     ) {
-        \SumUp\Hydrator::hydrate([
+        $this->fill([
             'client_transaction_id' => $clientTransactionId,
             'total_amount' => $totalAmount,
             'affiliate' => $affiliate,
             'tip_amount' => $tipAmount,
-        ], self::class, $this);
+        ]);
     }
 
     /**
@@ -61,13 +64,16 @@ class ReaderPaymentRequestParams
      */
     public static function fromArray(array $data): self
     {
-        self::assertRequiredFields($data, [
+        $required = self::requiredFields($data, [
             'client_transaction_id' => 'clientTransactionId',
             'total_amount' => 'totalAmount',
         ]);
 
-        $request = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
-        \SumUp\Hydrator::hydrate($data, self::class, $request);
+        $request = new self(
+            (string) $required['clientTransactionId'],
+            $required['totalAmount'] instanceof Amount ? $required['totalAmount'] : Amount::fromArray($required['totalAmount'])
+        );
+        $request->fill($data);
 
         return $request;
     }
@@ -75,14 +81,81 @@ class ReaderPaymentRequestParams
     /**
      * @param array<string, mixed> $data
      * @param array<string, string> $requiredFields
+     *
+     * @return array<string, mixed>
      */
-    private static function assertRequiredFields(array $data, array $requiredFields): void
+    private static function requiredFields(array $data, array $requiredFields): array
     {
+        $values = [];
         foreach ($requiredFields as $serializedName => $propertyName) {
-            if (!array_key_exists($serializedName, $data) && !array_key_exists($propertyName, $data)) {
+            if (array_key_exists($serializedName, $data)) {
+                $values[$propertyName] = $data[$serializedName];
+            } elseif (array_key_exists($propertyName, $data)) {
+                $values[$propertyName] = $data[$propertyName];
+            } else {
                 throw new \InvalidArgumentException(sprintf('Missing required field "%s".', $serializedName));
             }
         }
+
+        return $values;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'affiliate':
+                    $this->affiliate = $value instanceof Affiliate || $value === null ? $value : Affiliate::fromArray($value);
+                    break;
+                case 'client_transaction_id':
+                case 'clientTransactionId':
+                    $this->clientTransactionId = (string) $value;
+                    break;
+                case 'tip_amount':
+                case 'tipAmount':
+                    $this->tipAmount = $value === null ? null : (int) $value;
+                    break;
+                case 'total_amount':
+                case 'totalAmount':
+                    $this->totalAmount = $value instanceof Amount ? $value : Amount::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
     }
 
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->affiliate)) {
+            $data['affiliate'] = $this->affiliate->toArray();
+        }
+        if (isset($this->clientTransactionId)) {
+            $data['client_transaction_id'] = $this->clientTransactionId;
+        }
+        if (isset($this->tipAmount)) {
+            $data['tip_amount'] = $this->tipAmount;
+        }
+        if (isset($this->totalAmount)) {
+            $data['total_amount'] = $this->totalAmount->toArray();
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,8 +4,13 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class ReaderPaymentResponseData
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
+class ReaderPaymentResponseData implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Caller-supplied correlation identifier that was provided in the request.
      *
@@ -20,4 +25,62 @@ class ReaderPaymentResponseData
      */
     public ?string $transactionCode = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'client_transaction_id':
+                case 'clientTransactionId':
+                    $this->clientTransactionId = $value === null ? null : (string) $value;
+                    break;
+                case 'transaction_code':
+                case 'transactionCode':
+                    $this->transactionCode = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->clientTransactionId)) {
+            $data['client_transaction_id'] = $this->clientTransactionId;
+        }
+        if (isset($this->transactionCode)) {
+            $data['transaction_code'] = $this->transactionCode;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
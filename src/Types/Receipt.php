@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Receipt details for a transaction.
  */
-class Receipt
+class Receipt implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Transaction details displayed on a receipt.
      *
@@ -37,4 +42,76 @@ class Receipt
      */
     public ?ReceiptAcquirerData $acquirerData = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'transaction_data':
+                case 'transactionData':
+                    $this->transactionData = $value instanceof ReceiptTransaction || $value === null ? $value : ReceiptTransaction::fromArray($value);
+                    break;
+                case 'merchant_data':
+                case 'merchantData':
+                    $this->merchantData = $value instanceof ReceiptMerchantData || $value === null ? $value : ReceiptMerchantData::fromArray($value);
+                    break;
+                case 'emv_data':
+                case 'emvData':
+                    $this->emvData = $value === null ? null : (array) $value;
+                    break;
+                case 'acquirer_data':
+                case 'acquirerData':
+                    $this->acquirerData = $value instanceof ReceiptAcquirerData || $value === null ? $value : ReceiptAcquirerData::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->transactionData)) {
+            $data['transaction_data'] = $this->transactionData->toArray();
+        }
+        if (isset($this->merchantData)) {
+            $data['merchant_data'] = $this->merchantData->toArray();
+        }
+        if (isset($this->emvData)) {
+            $data['emv_data'] = $this->emvData;
+        }
+        if (isset($this->acquirerData)) {
+            $data['acquirer_data'] = $this->acquirerData->toArray();
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Acquirer-specific metadata related to the card authorization.
  */
-class ReceiptAcquirerData
+class ReceiptAcquirerData implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Identifier of the terminal used for the authorization.
      *
@@ -37,4 +42,75 @@ class ReceiptAcquirerData
      */
     public ?string $localTime = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'tid':
+                    $this->tid = $value === null ? null : (string) $value;
+                    break;
+                case 'authorization_code':
+                case 'authorizationCode':
+                    $this->authorizationCode = $value === null ? null : (string) $value;
+                    break;
+                case 'return_code':
+                case 'returnCode':
+                    $this->returnCode = $value === null ? null : (string) $value;
+                    break;
+                case 'local_time':
+                case 'localTime':
+                    $this->localTime = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->tid)) {
+            $data['tid'] = $this->tid;
+        }
+        if (isset($this->authorizationCode)) {
+            $data['authorization_code'] = $this->authorizationCode;
+        }
+        if (isset($this->returnCode)) {
+            $data['return_code'] = $this->returnCode;
+        }
+        if (isset($this->localTime)) {
+            $data['local_time'] = $this->localTime;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Transaction event details as rendered on the receipt.
  */
-class ReceiptEvent
+class ReceiptEvent implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique identifier of the transaction event.
      *
@@ -32,7 +37,9 @@ class ReceiptEvent
 
     /**
      * Status of the transaction event.
+     *
      * Not every value is used for every event type.
+     *
      * - `PENDING`: The event has been created but is not final yet. Used for events that are still being processed and whose final outcome is not known yet.
      * - `SCHEDULED`: The event is planned for a future payout cycle but has not been executed yet. This applies to payout events before money is actually sent out.
      * - `RECONCILED`: The underlying payment has been matched with settlement data and is ready to continue through payout processing, but the funds have not been paid out yet. This applies to payout events.
@@ -66,4 +73,92 @@ class ReceiptEvent
      */
     public ?string $receiptNo = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = $value === null ? null : (int) $value;
+                    break;
+                case 'transaction_id':
+                case 'transactionId':
+                    $this->transactionId = $value === null ? null : (string) $value;
+                    break;
+                case 'type':
+                    $this->type = $value instanceof ReceiptEventType || $value === null ? $value : ReceiptEventType::from($value);
+                    break;
+                case 'status':
+                    $this->status = $value instanceof ReceiptEventStatus || $value === null ? $value : ReceiptEventStatus::from($value);
+                    break;
+                case 'amount':
+                    $this->amount = $value === null ? null : (string) $value;
+                    break;
+                case 'timestamp':
+                    $this->timestamp = $value === null ? null : (string) $value;
+                    break;
+                case 'receipt_no':
+                case 'receiptNo':
+                    $this->receiptNo = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->transactionId)) {
+            $data['transaction_id'] = $this->transactionId;
+        }
+        if (isset($this->type)) {
+            $data['type'] = $this->type->value;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->timestamp)) {
+            $data['timestamp'] = $this->timestamp;
+        }
+        if (isset($this->receiptNo)) {
+            $data['receipt_no'] = $this->receiptNo;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
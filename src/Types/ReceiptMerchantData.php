@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Merchant details displayed on a transaction receipt.
  */
-class ReceiptMerchantData
+class ReceiptMerchantData implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Merchant profile details displayed on the receipt.
      *
@@ -23,4 +28,61 @@ class ReceiptMerchantData
      */
     public ?string $locale = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'merchant_profile':
+                case 'merchantProfile':
+                    $this->merchantProfile = $value instanceof ReceiptMerchantDataMerchantProfile || $value === null ? $value : ReceiptMerchantDataMerchantProfile::fromArray($value);
+                    break;
+                case 'locale':
+                    $this->locale = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->merchantProfile)) {
+            $data['merchant_profile'] = $this->merchantProfile->toArray();
+        }
+        if (isset($this->locale)) {
+            $data['locale'] = $this->locale;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Merchant profile details displayed on the receipt.
  */
-class ReceiptMerchantDataMerchantProfile
+class ReceiptMerchantDataMerchantProfile implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Short unique identifier for the merchant.
      *
@@ -65,4 +70,100 @@ class ReceiptMerchantDataMerchantProfile
      */
     public ?ReceiptMerchantDataMerchantProfileAddress $address = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'merchant_code':
+                case 'merchantCode':
+                    $this->merchantCode = $value === null ? null : (string) $value;
+                    break;
+                case 'business_name':
+                case 'businessName':
+                    $this->businessName = $value === null ? null : (string) $value;
+                    break;
+                case 'company_registration_number':
+                case 'companyRegistrationNumber':
+                    $this->companyRegistrationNumber = $value === null ? null : (string) $value;
+                    break;
+                case 'vat_id':
+                case 'vatId':
+                    $this->vatId = $value === null ? null : (string) $value;
+                    break;
+                case 'website':
+                    $this->website = $value === null ? null : (string) $value;
+                    break;
+                case 'email':
+                    $this->email = $value === null ? null : (string) $value;
+                    break;
+                case 'language':
+                    $this->language = $value === null ? null : (string) $value;
+                    break;
+                case 'address':
+                    $this->address = $value instanceof ReceiptMerchantDataMerchantProfileAddress || $value === null ? $value : ReceiptMerchantDataMerchantProfileAddress::fromArray($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->merchantCode)) {
+            $data['merchant_code'] = $this->merchantCode;
+        }
+        if (isset($this->businessName)) {
+            $data['business_name'] = $this->businessName;
+        }
+        if (isset($this->companyRegistrationNumber)) {
+            $data['company_registration_number'] = $this->companyRegistrationNumber;
+        }
+        if (isset($this->vatId)) {
+            $data['vat_id'] = $this->vatId;
+        }
+        if (isset($this->website)) {
+            $data['website'] = $this->website;
+        }
+        if (isset($this->email)) {
+            $data['email'] = $this->email;
+        }
+        if (isset($this->language)) {
+            $data['language'] = $this->language;
+        }
+        if (isset($this->address)) {
+            $data['address'] = $this->address->toArray();
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Business address of the merchant.
  */
-class ReceiptMerchantDataMerchantProfileAddress
+class ReceiptMerchantDataMerchantProfileAddress implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * First line of the merchant address.
      *
@@ -72,4 +77,108 @@ class ReceiptMerchantDataMerchantProfileAddress
      */
     public ?string $landline = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'address_line1':
+                case 'addressLine1':
+                    $this->addressLine1 = $value === null ? null : (string) $value;
+                    break;
+                case 'address_line2':
+                case 'addressLine2':
+                    $this->addressLine2 = $value === null ? null : (string) $value;
+                    break;
+                case 'city':
+                    $this->city = $value === null ? null : (string) $value;
+                    break;
+                case 'country':
+                    $this->country = $value === null ? null : (string) $value;
+                    break;
+                case 'country_en_name':
+                case 'countryEnName':
+                    $this->countryEnName = $value === null ? null : (string) $value;
+                    break;
+                case 'country_native_name':
+                case 'countryNativeName':
+                    $this->countryNativeName = $value === null ? null : (string) $value;
+                    break;
+                case 'region_name':
+                case 'regionName':
+                    $this->regionName = $value === null ? null : (string) $value;
+                    break;
+                case 'post_code':
+                case 'postCode':
+                    $this->postCode = $value === null ? null : (string) $value;
+                    break;
+                case 'landline':
+                    $this->landline = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->addressLine1)) {
+            $data['address_line1'] = $this->addressLine1;
+        }
+        if (isset($this->addressLine2)) {
+            $data['address_line2'] = $this->addressLine2;
+        }
+        if (isset($this->city)) {
+            $data['city'] = $this->city;
+        }
+        if (isset($this->country)) {
+            $data['country'] = $this->country;
+        }
+        if (isset($this->countryEnName)) {
+            $data['country_en_name'] = $this->countryEnName;
+        }
+        if (isset($this->countryNativeName)) {
+            $data['country_native_name'] = $this->countryNativeName;
+        }
+        if (isset($this->regionName)) {
+            $data['region_name'] = $this->regionName;
+        }
+        if (isset($this->postCode)) {
+            $data['post_code'] = $this->postCode;
+        }
+        if (isset($this->landline)) {
+            $data['landline'] = $this->landline;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
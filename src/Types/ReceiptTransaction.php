@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Transaction details displayed on a receipt.
  */
-class ReceiptTransaction
+class ReceiptTransaction implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Transaction code returned after processing the transaction.
      *
@@ -149,4 +154,181 @@ class ReceiptTransaction
      */
     public ?string $receiptNo = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'transaction_code':
+                case 'transactionCode':
+                    $this->transactionCode = $value === null ? null : (string) $value;
+                    break;
+                case 'transaction_id':
+                case 'transactionId':
+                    $this->transactionId = $value === null ? null : (string) $value;
+                    break;
+                case 'merchant_code':
+                case 'merchantCode':
+                    $this->merchantCode = $value === null ? null : (string) $value;
+                    break;
+                case 'amount':
+                    $this->amount = $value === null ? null : (string) $value;
+                    break;
+                case 'vat_amount':
+                case 'vatAmount':
+                    $this->vatAmount = $value === null ? null : (string) $value;
+                    break;
+                case 'tip_amount':
+                case 'tipAmount':
+                    $this->tipAmount = $value === null ? null : (string) $value;
+                    break;
+                case 'currency':
+                    $this->currency = $value === null ? null : (string) $value;
+                    break;
+                case 'timestamp':
+                    $this->timestamp = $value === null ? null : (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value === null ? null : (string) $value;
+                    break;
+                case 'payment_type':
+                case 'paymentType':
+                    $this->paymentType = $value === null ? null : (string) $value;
+                    break;
+                case 'entry_mode':
+                case 'entryMode':
+                    $this->entryMode = $value === null ? null : (string) $value;
+                    break;
+                case 'verification_method':
+                case 'verificationMethod':
+                    $this->verificationMethod = $value === null ? null : (string) $value;
+                    break;
+                case 'card_reader':
+                case 'cardReader':
+                    $this->cardReader = $value instanceof ReceiptReader || $value === null ? $value : ReceiptReader::fromArray($value);
+                    break;
+                case 'card':
+                    $this->card = $value instanceof ReceiptCard || $value === null ? $value : ReceiptCard::fromArray($value);
+                    break;
+                case 'installments_count':
+                case 'installmentsCount':
+                    $this->installmentsCount = $value === null ? null : (int) $value;
+                    break;
+                case 'process_as':
+                case 'processAs':
+                    $this->processAs = $value instanceof ReceiptTransactionProcessAs || $value === null ? $value : ReceiptTransactionProcessAs::from($value);
+                    break;
+                case 'products':
+                    $this->products = $value === null ? null : (array) $value;
+                    break;
+                case 'vat_rates':
+                case 'vatRates':
+                    $this->vatRates = $value === null ? null : (array) $value;
+                    break;
+                case 'events':
+                    $this->events = $value === null ? null : array_map(static fn ($item) => $item instanceof ReceiptEvent ? $item : ReceiptEvent::fromArray($item), (array) $value);
+                    break;
+                case 'receipt_no':
+                case 'receiptNo':
+                    $this->receiptNo = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->transactionCode)) {
+            $data['transaction_code'] = $this->transactionCode;
+        }
+        if (isset($this->transactionId)) {
+            $data['transaction_id'] = $this->transactionId;
+        }
+        if (isset($this->merchantCode)) {
+            $data['merchant_code'] = $this->merchantCode;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->vatAmount)) {
+            $data['vat_amount'] = $this->vatAmount;
+        }
+        if (isset($this->tipAmount)) {
+            $data['tip_amount'] = $this->tipAmount;
+        }
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency;
+        }
+        if (isset($this->timestamp)) {
+            $data['timestamp'] = $this->timestamp;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status;
+        }
+        if (isset($this->paymentType)) {
+            $data['payment_type'] = $this->paymentType;
+        }
+        if (isset($this->entryMode)) {
+            $data['entry_mode'] = $this->entryMode;
+        }
+        if (isset($this->verificationMethod)) {
+            $data['verification_method'] = $this->verificationMethod;
+        }
+        if (isset($this->cardReader)) {
+            $data['card_reader'] = $this->cardReader->toArray();
+        }
+        if (isset($this->card)) {
+            $data['card'] = $this->card->toArray();
+        }
+        if (isset($this->installmentsCount)) {
+            $data['installments_count'] = $this->installmentsCount;
+        }
+        if (isset($this->processAs)) {
+            $data['process_as'] = $this->processAs->value;
+        }
+        if (isset($this->products)) {
+            $data['products'] = $this->products;
+        }
+        if (isset($this->vatRates)) {
+            $data['vat_rates'] = $this->vatRates;
+        }
+        if (isset($this->events)) {
+            $data['events'] = array_map(static fn (ReceiptEvent $item) => $item->toArray(), $this->events);
+        }
+        if (isset($this->receiptNo)) {
+            $data['receipt_no'] = $this->receiptNo;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,8 +4,13 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
-class StatusResponseData
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
+class StatusResponseData implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Battery level percentage
      *
@@ -55,4 +60,95 @@ class StatusResponseData
      */
     public StatusResponseDataStatus $status;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'battery_level':
+                case 'batteryLevel':
+                    $this->batteryLevel = $value === null ? null : (float) $value;
+                    break;
+                case 'battery_temperature':
+                case 'batteryTemperature':
+                    $this->batteryTemperature = $value === null ? null : (int) $value;
+                    break;
+                case 'connection_type':
+                case 'connectionType':
+                    $this->connectionType = $value instanceof StatusResponseDataConnectionType || $value === null ? $value : StatusResponseDataConnectionType::from($value);
+                    break;
+                case 'firmware_version':
+                case 'firmwareVersion':
+                    $this->firmwareVersion = $value === null ? null : (string) $value;
+                    break;
+                case 'last_activity':
+                case 'lastActivity':
+                    $this->lastActivity = $value === null ? null : (string) $value;
+                    break;
+                case 'state':
+                    $this->state = $value instanceof StatusResponseDataState || $value === null ? $value : StatusResponseDataState::from($value);
+                    break;
+                case 'status':
+                    $this->status = $value instanceof StatusResponseDataStatus ? $value : StatusResponseDataStatus::from($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->batteryLevel)) {
+            $data['battery_level'] = $this->batteryLevel;
+        }
+        if (isset($this->batteryTemperature)) {
+            $data['battery_temperature'] = $this->batteryTemperature;
+        }
+        if (isset($this->connectionType)) {
+            $data['connection_type'] = $this->connectionType->value;
+        }
+        if (isset($this->firmwareVersion)) {
+            $data['firmware_version'] = $this->firmwareVersion;
+        }
+        if (isset($this->lastActivity)) {
+            $data['last_activity'] = $this->lastActivity;
+        }
+        if (isset($this->state)) {
+            $data['state'] = $this->state->value;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Core details shared by transaction resources.
  */
-class TransactionBase
+class TransactionBase implements TransactionBaseInterface, AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique identifier of the transaction.
      *
@@ -46,6 +51,7 @@ class TransactionBase
 
     /**
      * Current status of the transaction.
+     *
      * - `PENDING`: The transaction has been created but its final outcome is not known yet.
      * - `SUCCESSFUL`: The transaction completed successfully.
      * - `CANCELLED`: The transaction was cancelled or otherwise reversed before completion.
@@ -70,4 +76,99 @@ class TransactionBase
      */
     public ?int $installmentsCount = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = $value === null ? null : (string) $value;
+                    break;
+                case 'transaction_code':
+                case 'transactionCode':
+                    $this->transactionCode = $value === null ? null : (string) $value;
+                    break;
+                case 'amount':
+                    $this->amount = $value === null ? null : (float) $value;
+                    break;
+                case 'currency':
+                    $this->currency = $value instanceof TransactionBaseCurrency || $value === null ? $value : TransactionBaseCurrency::from($value);
+                    break;
+                case 'timestamp':
+                    $this->timestamp = $value === null ? null : (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value instanceof TransactionBaseStatus || $value === null ? $value : TransactionBaseStatus::from($value);
+                    break;
+                case 'payment_type':
+                case 'paymentType':
+                    $this->paymentType = $value instanceof TransactionBasePaymentType || $value === null ? $value : TransactionBasePaymentType::from($value);
+                    break;
+                case 'installments_count':
+                case 'installmentsCount':
+                    $this->installmentsCount = $value === null ? null : (int) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->transactionCode)) {
+            $data['transaction_code'] = $this->transactionCode;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency->value;
+        }
+        if (isset($this->timestamp)) {
+            $data['timestamp'] = $this->timestamp;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->paymentType)) {
+            $data['payment_type'] = $this->paymentType->value;
+        }
+        if (isset($this->installmentsCount)) {
+            $data['installments_count'] = $this->installmentsCount;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
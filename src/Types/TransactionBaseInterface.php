@@ -0,0 +1,12 @@
+<?php
+
+declare(strict_types=1);
+
+namespace SumUp\Types;
+
+/**
+ * Implemented by TransactionBase and every schema composed from it.
+ */
+interface TransactionBaseInterface
+{
+}
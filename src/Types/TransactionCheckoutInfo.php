@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Checkout-specific fields associated with a transaction.
  */
-class TransactionCheckoutInfo
+class TransactionCheckoutInfo implements TransactionCheckoutInfoInterface, AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique code of the registered merchant to whom the payment is made.
      *
@@ -44,4 +49,83 @@ class TransactionCheckoutInfo
      */
     public ?string $authCode = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'merchant_code':
+                case 'merchantCode':
+                    $this->merchantCode = $value === null ? null : (string) $value;
+                    break;
+                case 'vat_amount':
+                case 'vatAmount':
+                    $this->vatAmount = $value === null ? null : (float) $value;
+                    break;
+                case 'tip_amount':
+                case 'tipAmount':
+                    $this->tipAmount = $value === null ? null : (float) $value;
+                    break;
+                case 'entry_mode':
+                case 'entryMode':
+                    $this->entryMode = $value instanceof TransactionCheckoutInfoEntryMode || $value === null ? $value : TransactionCheckoutInfoEntryMode::from($value);
+                    break;
+                case 'auth_code':
+                case 'authCode':
+                    $this->authCode = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->merchantCode)) {
+            $data['merchant_code'] = $this->merchantCode;
+        }
+        if (isset($this->vatAmount)) {
+            $data['vat_amount'] = $this->vatAmount;
+        }
+        if (isset($this->tipAmount)) {
+            $data['tip_amount'] = $this->tipAmount;
+        }
+        if (isset($this->entryMode)) {
+            $data['entry_mode'] = $this->entryMode->value;
+        }
+        if (isset($this->authCode)) {
+            $data['auth_code'] = $this->authCode;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
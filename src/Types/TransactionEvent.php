@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Detailed information about a transaction event.
  */
-class TransactionEvent
+class TransactionEvent implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique identifier of the transaction event.
      *
@@ -25,7 +30,9 @@ class TransactionEvent
 
     /**
      * Status of the transaction event.
+     *
      * Not every value is used for every event type.
+     *
      * - `PENDING`: The event has been created but is not final yet. Used for events that are still being processed and whose final outcome is not known yet.
      * - `SCHEDULED`: The event is planned for a future payout cycle but has not been executed yet. This applies to payout events before money is actually sent out.
      * - `RECONCILED`: The underlying payment has been matched with settlement data and is ready to continue through payout processing, but the funds have not been paid out yet. This applies to payout events.
@@ -73,4 +80,99 @@ class TransactionEvent
      */
     public ?string $timestamp = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = $value === null ? null : (int) $value;
+                    break;
+                case 'event_type':
+                case 'eventType':
+                    $this->eventType = $value instanceof TransactionEventEventType || $value === null ? $value : TransactionEventEventType::from($value);
+                    break;
+                case 'status':
+                    $this->status = $value instanceof TransactionEventStatus || $value === null ? $value : TransactionEventStatus::from($value);
+                    break;
+                case 'amount':
+                    $this->amount = $value === null ? null : (float) $value;
+                    break;
+                case 'due_date':
+                case 'dueDate':
+                    $this->dueDate = $value === null ? null : (string) $value;
+                    break;
+                case 'date':
+                    $this->date = $value === null ? null : (string) $value;
+                    break;
+                case 'installment_number':
+                case 'installmentNumber':
+                    $this->installmentNumber = $value === null ? null : (int) $value;
+                    break;
+                case 'timestamp':
+                    $this->timestamp = $value === null ? null : (string) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->eventType)) {
+            $data['event_type'] = $this->eventType->value;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status->value;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->dueDate)) {
+            $data['due_date'] = $this->dueDate;
+        }
+        if (isset($this->date)) {
+            $data['date'] = $this->date;
+        }
+        if (isset($this->installmentNumber)) {
+            $data['installment_number'] = $this->installmentNumber;
+        }
+        if (isset($this->timestamp)) {
+            $data['timestamp'] = $this->timestamp;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
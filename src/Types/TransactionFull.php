@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Full transaction resource with checkout, payout, and event details.
  */
-class TransactionFull
+class TransactionFull implements TransactionBaseInterface, TransactionCheckoutInfoInterface, TransactionMixinHistoryInterface, AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique identifier of the transaction.
      *
@@ -46,6 +51,7 @@ class TransactionFull
 
     /**
      * Current status of the transaction.
+     *
      * - `PENDING`: The transaction has been created but its final outcome is not known yet.
      * - `SUCCESSFUL`: The transaction completed successfully.
      * - `CANCELLED`: The transaction was cancelled or otherwise reversed before completion.
@@ -275,6 +281,7 @@ class TransactionFull
 
     /**
      * High-level status of the transaction from the merchant's perspective.
+     *
      * - `PENDING`: The payment has been initiated and is still being processed. A final outcome is not available yet.
      * - `SUCCESSFUL`: The payment was completed successfully.
      * - `PAID_OUT`: The payment was completed successfully and the funds have already been included in a payout to the merchant.
@@ -318,4 +325,329 @@ class TransactionFull
      */
     public ?bool $taxEnabled = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = $value === null ? null : (string) $value;
+                    break;
+                case 'transaction_code':
+                case 'transactionCode':
+                    $this->transactionCode = $value === null ? null : (string) $value;
+                    break;
+                case 'amount':
+                    $this->amount = $value === null ? null : (float) $value;
+                    break;
+                case 'currency':
+                    $this->currency = $value === null ? null : (string) $value;
+                    break;
+                case 'timestamp':
+                    $this->timestamp = $value === null ? null : (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value === null ? null : (string) $value;
+                    break;
+                case 'payment_type':
+                case 'paymentType':
+                    $this->paymentType = $value === null ? null : (string) $value;
+                    break;
+                case 'installments_count':
+                case 'installmentsCount':
+                    $this->installmentsCount = $value === null ? null : (int) $value;
+                    break;
+                case 'merchant_code':
+                case 'merchantCode':
+                    $this->merchantCode = $value === null ? null : (string) $value;
+                    break;
+                case 'vat_amount':
+                case 'vatAmount':
+                    $this->vatAmount = $value === null ? null : (float) $value;
+                    break;
+                case 'tip_amount':
+                case 'tipAmount':
+                    $this->tipAmount = $value === null ? null : (float) $value;
+                    break;
+                case 'entry_mode':
+                case 'entryMode':
+                    $this->entryMode = $value === null ? null : (string) $value;
+                    break;
+                case 'auth_code':
+                case 'authCode':
+                    $this->authCode = $value === null ? null : (string) $value;
+                    break;
+                case 'product_summary':
+                case 'productSummary':
+                    $this->productSummary = $value === null ? null : (string) $value;
+                    break;
+                case 'payouts_total':
+                case 'payoutsTotal':
+                    $this->payoutsTotal = $value === null ? null : (int) $value;
+                    break;
+                case 'payouts_received':
+                case 'payoutsReceived':
+                    $this->payoutsReceived = $value === null ? null : (int) $value;
+                    break;
+                case 'payout_plan':
+                case 'payoutPlan':
+                    $this->payoutPlan = $value === null ? null : (string) $value;
+                    break;
+                case 'foreign_transaction_id':
+                case 'foreignTransactionId':
+                    $this->foreignTransactionId = $value === null ? null : (string) $value;
+                    break;
+                case 'client_transaction_id':
+                case 'clientTransactionId':
+                    $this->clientTransactionId = $value === null ? null : (string) $value;
+                    break;
+                case 'username':
+                    $this->username = $value === null ? null : (string) $value;
+                    break;
+                case 'fee_amount':
+                case 'feeAmount':
+                    $this->feeAmount = $value === null ? null : (float) $value;
+                    break;
+                case 'lat':
+                    $this->lat = $value === null ? null : (float) $value;
+                    break;
+                case 'lon':
+                    $this->lon = $value === null ? null : (float) $value;
+                    break;
+                case 'horizontal_accuracy':
+                case 'horizontalAccuracy':
+                    $this->horizontalAccuracy = $value === null ? null : (float) $value;
+                    break;
+                case 'merchant_id':
+                case 'merchantId':
+                    $this->merchantId = $value === null ? null : (int) $value;
+                    break;
+                case 'device_info':
+                case 'deviceInfo':
+                    $this->deviceInfo = $value instanceof Device || $value === null ? $value : Device::fromArray($value);
+                    break;
+                case 'simple_payment_type':
+                case 'simplePaymentType':
+                    $this->simplePaymentType = $value instanceof TransactionFullSimplePaymentType || $value === null ? $value : TransactionFullSimplePaymentType::from($value);
+                    break;
+                case 'verification_method':
+                case 'verificationMethod':
+                    $this->verificationMethod = $value instanceof TransactionFullVerificationMethod || $value === null ? $value : TransactionFullVerificationMethod::from($value);
+                    break;
+                case 'card':
+                    $this->card = $value instanceof CardResponse || $value === null ? $value : CardResponse::fromArray($value);
+                    break;
+                case 'elv_account':
+                case 'elvAccount':
+                    $this->elvAccount = $value instanceof ElvCardAccount || $value === null ? $value : ElvCardAccount::fromArray($value);
+                    break;
+                case 'local_time':
+                case 'localTime':
+                    $this->localTime = $value === null ? null : (string) $value;
+                    break;
+                case 'payout_date':
+                case 'payoutDate':
+                    $this->payoutDate = $value === null ? null : (string) $value;
+                    break;
+                case 'payout_type':
+                case 'payoutType':
+                    $this->payoutType = $value instanceof TransactionFullPayoutType || $value === null ? $value : TransactionFullPayoutType::from($value);
+                    break;
+                case 'process_as':
+                case 'processAs':
+                    $this->processAs = $value instanceof TransactionFullProcessAs || $value === null ? $value : TransactionFullProcessAs::from($value);
+                    break;
+                case 'products':
+                    $this->products = $value === null ? null : array_map(static fn ($item) => $item instanceof Product ? $item : Product::fromArray($item), (array) $value);
+                    break;
+                case 'vat_rates':
+                case 'vatRates':
+                    $this->vatRates = $value === null ? null : (array) $value;
+                    break;
+                case 'transaction_events':
+                case 'transactionEvents':
+                    $this->transactionEvents = $value === null ? null : array_map(static fn ($item) => $item instanceof TransactionEvent ? $item : TransactionEvent::fromArray($item), (array) $value);
+                    break;
+                case 'simple_status':
+                case 'simpleStatus':
+                    $this->simpleStatus = $value instanceof TransactionFullSimpleStatus || $value === null ? $value : TransactionFullSimpleStatus::from($value);
+                    break;
+                case 'links':
+                    $this->links = $value === null ? null : array_map(static fn ($item) => $item instanceof Link ? $item : Link::fromArray($item), (array) $value);
+                    break;
+                case 'events':
+                    $this->events = $value === null ? null : array_map(static fn ($item) => $item instanceof Event ? $item : Event::fromArray($item), (array) $value);
+                    break;
+                case 'location':
+                    $this->location = $value instanceof TransactionFullLocation || $value === null ? $value : TransactionFullLocation::fromArray($value);
+                    break;
+                case 'tax_enabled':
+                case 'taxEnabled':
+                    $this->taxEnabled = $value === null ? null : (bool) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->transactionCode)) {
+            $data['transaction_code'] = $this->transactionCode;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency;
+        }
+        if (isset($this->timestamp)) {
+            $data['timestamp'] = $this->timestamp;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status;
+        }
+        if (isset($this->paymentType)) {
+            $data['payment_type'] = $this->paymentType;
+        }
+        if (isset($this->installmentsCount)) {
+            $data['installments_count'] = $this->installmentsCount;
+        }
+        if (isset($this->merchantCode)) {
+            $data['merchant_code'] = $this->merchantCode;
+        }
+        if (isset($this->vatAmount)) {
+            $data['vat_amount'] = $this->vatAmount;
+        }
+        if (isset($this->tipAmount)) {
+            $data['tip_amount'] = $this->tipAmount;
+        }
+        if (isset($this->entryMode)) {
+            $data['entry_mode'] = $this->entryMode;
+        }
+        if (isset($this->authCode)) {
+            $data['auth_code'] = $this->authCode;
+        }
+        if (isset($this->productSummary)) {
+            $data['product_summary'] = $this->productSummary;
+        }
+        if (isset($this->payoutsTotal)) {
+            $data['payouts_total'] = $this->payoutsTotal;
+        }
+        if (isset($this->payoutsReceived)) {
+            $data['payouts_received'] = $this->payoutsReceived;
+        }
+        if (isset($this->payoutPlan)) {
+            $data['payout_plan'] = $this->payoutPlan;
+        }
+        if (isset($this->foreignTransactionId)) {
+            $data['foreign_transaction_id'] = $this->foreignTransactionId;
+        }
+        if (isset($this->clientTransactionId)) {
+            $data['client_transaction_id'] = $this->clientTransactionId;
+        }
+        if (isset($this->username)) {
+            $data['username'] = $this->username;
+        }
+        if (isset($this->feeAmount)) {
+            $data['fee_amount'] = $this->feeAmount;
+        }
+        if (isset($this->lat)) {
+            $data['lat'] = $this->lat;
+        }
+        if (isset($this->lon)) {
+            $data['lon'] = $this->lon;
+        }
+        if (isset($this->horizontalAccuracy)) {
+            $data['horizontal_accuracy'] = $this->horizontalAccuracy;
+        }
+        if (isset($this->merchantId)) {
+            $data['merchant_id'] = $this->merchantId;
+        }
+        if (isset($this->deviceInfo)) {
+            $data['device_info'] = $this->deviceInfo->toArray();
+        }
+        if (isset($this->simplePaymentType)) {
+            $data['simple_payment_type'] = $this->simplePaymentType->value;
+        }
+        if (isset($this->verificationMethod)) {
+            $data['verification_method'] = $this->verificationMethod->value;
+        }
+        if (isset($this->card)) {
+            $data['card'] = $this->card->toArray();
+        }
+        if (isset($this->elvAccount)) {
+            $data['elv_account'] = $this->elvAccount->toArray();
+        }
+        if (isset($this->localTime)) {
+            $data['local_time'] = $this->localTime;
+        }
+        if (isset($this->payoutDate)) {
+            $data['payout_date'] = $this->payoutDate;
+        }
+        if (isset($this->payoutType)) {
+            $data['payout_type'] = $this->payoutType->value;
+        }
+        if (isset($this->processAs)) {
+            $data['process_as'] = $this->processAs->value;
+        }
+        if (isset($this->products)) {
+            $data['products'] = array_map(static fn (Product $item) => $item->toArray(), $this->products);
+        }
+        if (isset($this->vatRates)) {
+            $data['vat_rates'] = $this->vatRates;
+        }
+        if (isset($this->transactionEvents)) {
+            $data['transaction_events'] = array_map(static fn (TransactionEvent $item) => $item->toArray(), $this->transactionEvents);
+        }
+        if (isset($this->simpleStatus)) {
+            $data['simple_status'] = $this->simpleStatus->value;
+        }
+        if (isset($this->links)) {
+            $data['links'] = array_map(static fn (Link $item) => $item->toArray(), $this->links);
+        }
+        if (isset($this->events)) {
+            $data['events'] = array_map(static fn (Event $item) => $item->toArray(), $this->events);
+        }
+        if (isset($this->location)) {
+            $data['location'] = $this->location->toArray();
+        }
+        if (isset($this->taxEnabled)) {
+            $data['tax_enabled'] = $this->taxEnabled;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Details of the payment location as received from the payment terminal.
  */
-class TransactionFullLocation
+class TransactionFullLocation implements AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Latitude value from the coordinates of the payment location (as received from the payment terminal reader).
      *
@@ -30,4 +35,67 @@ class TransactionFullLocation
      */
     public ?float $horizontalAccuracy = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'lat':
+                    $this->lat = $value === null ? null : (float) $value;
+                    break;
+                case 'lon':
+                    $this->lon = $value === null ? null : (float) $value;
+                    break;
+                case 'horizontal_accuracy':
+                case 'horizontalAccuracy':
+                    $this->horizontalAccuracy = $value === null ? null : (float) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->lat)) {
+            $data['lat'] = $this->lat;
+        }
+        if (isset($this->lon)) {
+            $data['lon'] = $this->lon;
+        }
+        if (isset($this->horizontalAccuracy)) {
+            $data['horizontal_accuracy'] = $this->horizontalAccuracy;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Transaction entry returned in history listing responses.
  */
-class TransactionHistory
+class TransactionHistory implements TransactionBaseInterface, TransactionMixinHistoryInterface, AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Unique identifier of the transaction.
      *
@@ -46,6 +51,7 @@ class TransactionHistory
 
     /**
      * Current status of the transaction.
+     *
      * - `PENDING`: The transaction has been created but its final outcome is not known yet.
      * - `SUCCESSFUL`: The transaction completed successfully.
      * - `CANCELLED`: The transaction was cancelled or otherwise reversed before completion.
@@ -154,4 +160,181 @@ class TransactionHistory
      */
     public ?float $refundedAmount = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'id':
+                    $this->id = $value === null ? null : (string) $value;
+                    break;
+                case 'transaction_code':
+                case 'transactionCode':
+                    $this->transactionCode = $value === null ? null : (string) $value;
+                    break;
+                case 'amount':
+                    $this->amount = $value === null ? null : (float) $value;
+                    break;
+                case 'currency':
+                    $this->currency = $value === null ? null : (string) $value;
+                    break;
+                case 'timestamp':
+                    $this->timestamp = $value === null ? null : (string) $value;
+                    break;
+                case 'status':
+                    $this->status = $value === null ? null : (string) $value;
+                    break;
+                case 'payment_type':
+                case 'paymentType':
+                    $this->paymentType = $value === null ? null : (string) $value;
+                    break;
+                case 'installments_count':
+                case 'installmentsCount':
+                    $this->installmentsCount = $value === null ? null : (int) $value;
+                    break;
+                case 'product_summary':
+                case 'productSummary':
+                    $this->productSummary = $value === null ? null : (string) $value;
+                    break;
+                case 'payouts_total':
+                case 'payoutsTotal':
+                    $this->payoutsTotal = $value === null ? null : (int) $value;
+                    break;
+                case 'payouts_received':
+                case 'payoutsReceived':
+                    $this->payoutsReceived = $value === null ? null : (int) $value;
+                    break;
+                case 'payout_plan':
+                case 'payoutPlan':
+                    $this->payoutPlan = $value === null ? null : (string) $value;
+                    break;
+                case 'transaction_id':
+                case 'transactionId':
+                    $this->transactionId = $value === null ? null : (string) $value;
+                    break;
+                case 'client_transaction_id':
+                case 'clientTransactionId':
+                    $this->clientTransactionId = $value === null ? null : (string) $value;
+                    break;
+                case 'user':
+                    $this->user = $value === null ? null : (string) $value;
+                    break;
+                case 'type':
+                    $this->type = $value instanceof TransactionHistoryType || $value === null ? $value : TransactionHistoryType::from($value);
+                    break;
+                case 'card_type':
+                case 'cardType':
+                    $this->cardType = $value instanceof TransactionHistoryCardType || $value === null ? $value : TransactionHistoryCardType::from($value);
+                    break;
+                case 'payout_date':
+                case 'payoutDate':
+                    $this->payoutDate = $value === null ? null : (string) $value;
+                    break;
+                case 'payout_type':
+                case 'payoutType':
+                    $this->payoutType = $value instanceof TransactionHistoryPayoutType || $value === null ? $value : TransactionHistoryPayoutType::from($value);
+                    break;
+                case 'refunded_amount':
+                case 'refundedAmount':
+                    $this->refundedAmount = $value === null ? null : (float) $value;
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->id)) {
+            $data['id'] = $this->id;
+        }
+        if (isset($this->transactionCode)) {
+            $data['transaction_code'] = $this->transactionCode;
+        }
+        if (isset($this->amount)) {
+            $data['amount'] = $this->amount;
+        }
+        if (isset($this->currency)) {
+            $data['currency'] = $this->currency;
+        }
+        if (isset($this->timestamp)) {
+            $data['timestamp'] = $this->timestamp;
+        }
+        if (isset($this->status)) {
+            $data['status'] = $this->status;
+        }
+        if (isset($this->paymentType)) {
+            $data['payment_type'] = $this->paymentType;
+        }
+        if (isset($this->installmentsCount)) {
+            $data['installments_count'] = $this->installmentsCount;
+        }
+        if (isset($this->productSummary)) {
+            $data['product_summary'] = $this->productSummary;
+        }
+        if (isset($this->payoutsTotal)) {
+            $data['payouts_total'] = $this->payoutsTotal;
+        }
+        if (isset($this->payoutsReceived)) {
+            $data['payouts_received'] = $this->payoutsReceived;
+        }
+        if (isset($this->payoutPlan)) {
+            $data['payout_plan'] = $this->payoutPlan;
+        }
+        if (isset($this->transactionId)) {
+            $data['transaction_id'] = $this->transactionId;
+        }
+        if (isset($this->clientTransactionId)) {
+            $data['client_transaction_id'] = $this->clientTransactionId;
+        }
+        if (isset($this->user)) {
+            $data['user'] = $this->user;
+        }
+        if (isset($this->type)) {
+            $data['type'] = $this->type->value;
+        }
+        if (isset($this->cardType)) {
+            $data['card_type'] = $this->cardType->value;
+        }
+        if (isset($this->payoutDate)) {
+            $data['payout_date'] = $this->payoutDate;
+        }
+        if (isset($this->payoutType)) {
+            $data['payout_type'] = $this->payoutType->value;
+        }
+        if (isset($this->refundedAmount)) {
+            $data['refunded_amount'] = $this->refundedAmount;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
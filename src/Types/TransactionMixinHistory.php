@@ -4,11 +4,16 @@ declare(strict_types=1);
 
 namespace SumUp\Types;
 
+use SumUp\AdditionalPropertiesInterface;
+use SumUp\AdditionalPropertiesTrait;
+
 /**
  * Additional transaction fields used by history and detailed views.
  */
-class TransactionMixinHistory
+class TransactionMixinHistory implements TransactionMixinHistoryInterface, AdditionalPropertiesInterface
 {
+    use AdditionalPropertiesTrait;
+
     /**
      * Short description of the payment. The value is taken from the `description` property of the related checkout resource.
      *
@@ -37,4 +42,76 @@ class TransactionMixinHistory
      */
     public ?TransactionMixinHistoryPayoutPlan $payoutPlan = null;
 
+    /**
+     * Create an instance from an associative array payload.
+     *
+     * @param array<string, mixed> $data
+     */
+    public static function fromArray(array $data): self
+    {
+        $instance = new self();
+        $instance->fill($data);
+
+        return $instance;
+    }
+
+    /**
+     * Assign the known fields of a payload, keeping the others as additional properties.
+     *
+     * @param array<string, mixed> $data
+     */
+    private function fill(array $data): void
+    {
+        $additionalProperties = [];
+        foreach ($data as $key => $value) {
+            switch ($key) {
+                case 'product_summary':
+                case 'productSummary':
+                    $this->productSummary = $value === null ? null : (string) $value;
+                    break;
+                case 'payouts_total':
+                case 'payoutsTotal':
+                    $this->payoutsTotal = $value === null ? null : (int) $value;
+                    break;
+                case 'payouts_received':
+                case 'payoutsReceived':
+                    $this->payoutsReceived = $value === null ? null : (int) $value;
+                    break;
+                case 'payout_plan':
+                case 'payoutPlan':
+                    $this->payoutPlan = $value instanceof TransactionMixinHistoryPayoutPlan || $value === null ? $value : TransactionMixinHistoryPayoutPlan::from($value);
+                    break;
+                default:
+                    $additionalProperties[$key] = $value;
+            }
+        }
+
+        if ($additionalProperties !== []) {
+            $this->setAdditionalProperties(array_merge($this->additionalProperties(), $additionalProperties));
+        }
+    }
+
+    /**
+     * Convert the instance into a payload, omitting unset fields.
+     *
+     * @return array<string, mixed>
+     */
+    public function toArray(): array
+    {
+        $data = [];
+        if (isset($this->productSummary)) {
+            $data['product_summary'] = $this->productSummary;
+        }
+        if (isset($this->payoutsTotal)) {
+            $data['payouts_total'] = $this->payoutsTotal;
+        }
+        if (isset($this->payoutsReceived)) {
+            $data['payouts_received'] = $this->payoutsReceived;
+        }
+        if (isset($this->payoutPlan)) {
+            $data['payout_plan'] = $this->payoutPlan->value;
+        }
+
+        return $data + $this->additionalProperties();
+    }
 }
@@ -0,0 +1,12 @@
+<?php
+
+declare(strict_types=1);
+
+namespace SumUp\Types;
+
+/**
+ * Implemented by TransactionMixinHistory and every schema composed from it.
+ */
+interface TransactionMixinHistoryInterface
+{
+}
@@ -4,11 +4,18 @@ namespace SumUp\Tests;
 
 use PHPUnit\Framework\TestCase;
 use SumUp\Hydrator;
+use SumUp\Services\RolesListResponse;
 use SumUp\Types\Checkout;
 use SumUp\Types\CheckoutCurrency;
+use SumUp\Types\CheckoutSuccess;
 use SumUp\Types\MandateResponse;
 use SumUp\Types\MandateResponseStatus;
+use SumUp\Types\Merchant;
+use SumUp\Types\Meta;
 use SumUp\Types\Receipt;
+use SumUp\Types\Role;
+use SumUp\Types\TransactionBaseInterface;
+use SumUp\Types\TransactionHistory;
 
 class HydratorTest extends TestCase
 {
@@ -79,6 +86,110 @@ class HydratorTest extends TestCase
         $this->assertSame('https://example.test/2', $response->links[1]->href);
     }
 
+    public function testHydrateNamedTypedMapIntoMapClass()
+    {
+        $merchant = Hydrator::hydrate([
+            'meta' => [
+                'store' => 'berlin',
+                'terminal' => 42,
+            ],
+        ], Merchant::class);
+
+        $this->assertInstanceOf(Merchant::class, $merchant);
+        $this->assertInstanceOf(Meta::class, $merchant->meta);
+        $this->assertSame('berlin', $merchant->meta['store']);
+        $this->assertSame('42', $merchant->meta['terminal']);
+    }
+
+    public function testHydrateMapValuesFromDocblockClassNames()
+    {
+        $response = Hydrator::hydrate([
+            'linksByRel' => [
+                'self' => ['href' => 'https://example.test/self'],
+                'next' => ['href' => 'https://example.test/next'],
+            ],
+        ], HydratorArrayFixture::class);
+
+        $this->assertInstanceOf(HydratorArrayFixture::class, $response);
+        $this->assertSame(['self', 'next'], array_keys($response->linksByRel));
+        $this->assertInstanceOf(HydratorLinkFixture::class, $response->linksByRel['self']);
+        $this->assertSame('https://example.test/next', $response->linksByRel['next']->href);
+    }
+
+    public function testHydrateInheritedPropertiesOfAllOfComposition()
+    {
+        $checkout = Hydrator::hydrate([
+            'currency' => 'EUR',
+            'transaction_code' => 'TEENSK4W2K',
+        ], CheckoutSuccess::class);
+
+        $this->assertInstanceOf(Checkout::class, $checkout);
+        $this->assertSame(CheckoutCurrency::EUR, $checkout->currency);
+        $this->assertSame('TEENSK4W2K', $checkout->transactionCode);
+    }
+
+    public function testMultiParentCompositionImplementsParentInterfaces()
+    {
+        $transaction = Hydrator::hydrate([
+            'transaction_code' => 'TEENSK4W2K',
+        ], TransactionHistory::class);
+
+        $this->assertInstanceOf(TransactionBaseInterface::class, $transaction);
+        $this->assertSame('TEENSK4W2K', $transaction->transactionCode);
+    }
+
+    public function testHydrateKeepsUnknownFieldsAsAdditionalProperties()
+    {
+        $checkout = Hydrator::hydrate([
+            'id' => 'chk_123',
+            'risk_score' => 12,
+            'fraud' => ['status' => 'clear'],
+        ], Checkout::class);
+
+        $this->assertSame('chk_123', $checkout->id);
+        $this->assertSame([
+            'risk_score' => 12,
+            'fraud' => ['status' => 'clear'],
+        ], $checkout->additionalProperties());
+    }
+
+    public function testHydrateKeepsUnknownFieldsOfInheritedComposition()
+    {
+        $checkout = Hydrator::hydrate([
+            'transaction_code' => 'TEENSK4W2K',
+            'risk_score' => 12,
+        ], CheckoutSuccess::class);
+
+        $this->assertSame(['risk_score' => 12], $checkout->additionalProperties());
+    }
+
+    public function testHydrateDelegatesToGeneratedFromArray()
+    {
+        $response = Hydrator::hydrate([
+            'items' => [
+                ['id' => 'role_1', 'name' => 'Manager', 'permissions' => ['members_read'], 'is_predefined' => false],
+            ],
+        ], RolesListResponse::class);
+
+        $this->assertInstanceOf(RolesListResponse::class, $response);
+        $this->assertContainsOnlyInstancesOf(Role::class, $response->items);
+        $this->assertSame('Manager', $response->items[0]->name);
+        $this->assertSame(['members_read'], $response->items[0]->permissions);
+        $this->assertFalse($response->items[0]->isPredefined);
+    }
+
+    public function testGeneratedToArrayRestoresSerializedNames()
+    {
+        $payload = [
+            'checkout_reference' => 'order-123',
+            'currency' => 'EUR',
+            'mandate' => ['status' => 'active'],
+            'risk_score' => 12,
+        ];
+
+        $this->assertSame($payload, Checkout::fromArray($payload)->toArray());
+    }
+
     public function testHydrateInvalidBackedEnumValueThrowsValueError()
     {
         $this->expectException(\ValueError::class);
@@ -95,6 +206,11 @@ class HydratorArrayFixture
      * @var HydratorLinkFixture[]|null
      */
     public ?array $links = null;
+
+    /**
+     * @var array<string, HydratorLinkFixture>|null
+     */
+    public ?array $linksByRel = null;
 }
 
 class HydratorLinkFixture
@@ -4,8 +4,12 @@ namespace SumUp\Tests;
 
 use PHPUnit\Framework\TestCase;
 use SumUp\RequestEncoder;
+use SumUp\Services\RolesCreateRequest;
+use SumUp\Services\RolesUpdateRequest;
+use SumUp\Types\Meta;
 use SumUp\Types\CheckoutCreateRequest;
 use SumUp\Types\CheckoutCreateRequestCurrency;
+use SumUp\Types\CheckoutCreateRequestPurpose;
 
 class RequestEncoderTest extends TestCase
 {
@@ -33,6 +37,43 @@ class RequestEncoderTest extends TestCase
         $this->assertSame('MC123', $encoded['merchant_code']);
     }
 
+    public function testEncodeDelegatesToGeneratedToArray()
+    {
+        $request = new CheckoutCreateRequest(
+            checkoutReference: 'order-123',
+            amount: 10.0,
+            currency: 'EUR',
+            merchantCode: 'MC123',
+        );
+
+        $this->assertSame($request->toArray(), RequestEncoder::encode($request));
+        $this->assertSame([
+            'checkout_reference' => 'order-123',
+            'amount' => 10.0,
+            'currency' => 'EUR',
+            'merchant_code' => 'MC123',
+        ], $request->toArray());
+    }
+
+    public function testEncodeSendsDefaultOnlyWhenChangedOrSetThroughFromArray()
+    {
+        $data = [
+            'checkout_reference' => 'order-123',
+            'amount' => 10.0,
+            'currency' => 'EUR',
+            'merchant_code' => 'MC123',
+        ];
+
+        $this->assertArrayNotHasKey('purpose', RequestEncoder::encode(CheckoutCreateRequest::fromArray($data)));
+
+        $encoded = RequestEncoder::encode(CheckoutCreateRequest::fromArray($data + ['purpose' => 'CHECKOUT']));
+        $this->assertSame('CHECKOUT', $encoded['purpose']);
+
+        $request = CheckoutCreateRequest::fromArray($data);
+        $request->purpose = CheckoutCreateRequestPurpose::SETUP_RECURRING_PAYMENT;
+        $this->assertSame('SETUP_RECURRING_PAYMENT', RequestEncoder::encode($request)['purpose']);
+    }
+
     public function testEncodeRecursivelyNormalizesNestedObjects()
     {
         $encoded = RequestEncoder::encode(new RequestEncoderFixture());
@@ -43,6 +84,73 @@ class RequestEncoderTest extends TestCase
         $this->assertSame('item-1', $encoded['items'][0]['item_id']);
         $this->assertSame('item-2', $encoded['items'][1]['item_id']);
     }
+
+    public function testEncodeConvertsMapClassToArrayPreservingKeys()
+    {
+        $fixture = new RequestEncoderMapFixture();
+        $fixture->meta = new Meta(['storeName' => 'berlin']);
+
+        $encoded = RequestEncoder::encode($fixture);
+
+        $this->assertSame(['meta' => ['storeName' => 'berlin']], $encoded);
+    }
+
+    public function testEncodeOmitsUntouchedNullFieldsOfPatchBody()
+    {
+        $encoded = RequestEncoder::encode(new RolesUpdateRequest(name: 'Manager'));
+
+        $this->assertSame(['name' => 'Manager'], $encoded);
+    }
+
+    public function testEncodeOmitsNullConstructorArgumentsOfPatchBody()
+    {
+        $encoded = RequestEncoder::encode(new RolesUpdateRequest(name: 'Manager', description: null));
+
+        $this->assertSame(['name' => 'Manager'], $encoded);
+    }
+
+    public function testEncodeSendsExplicitNullSetThroughSetter()
+    {
+        $request = (new RolesUpdateRequest(name: 'Manager'))->setDescription(null);
+
+        $encoded = RequestEncoder::encode($request);
+
+        $this->assertSame(['name' => 'Manager', 'description' => null], $encoded);
+    }
+
+    public function testEncodeSendsExplicitNullSetThroughFromArray()
+    {
+        $encoded = RequestEncoder::encode(RolesUpdateRequest::fromArray([
+            'description' => null,
+        ]));
+
+        $this->assertSame(['description' => null], $encoded);
+    }
+
+    public function testEncodeResendsAdditionalPropertiesFromFromArray()
+    {
+        $encoded = RequestEncoder::encode(RolesCreateRequest::fromArray([
+            'name' => 'Manager',
+            'permissions' => ['members_read'],
+            'scope' => 'merchant',
+        ]));
+
+        $this->assertSame([
+            'name' => 'Manager',
+            'permissions' => ['members_read'],
+            'scope' => 'merchant',
+        ], $encoded);
+    }
+
+    public function testEncodeDoesNotOverrideDeclaredPropertiesWithAdditionalProperties()
+    {
+        $request = new RolesCreateRequest(name: 'Manager', permissions: []);
+        $request->setAdditionalProperties(['name' => 'Other', 'scope' => 'merchant']);
+
+        $encoded = RequestEncoder::encode($request);
+
+        $this->assertSame(['name' => 'Manager', 'permissions' => [], 'scope' => 'merchant'], $encoded);
+    }
 }
 
 class RequestEncoderFixture
@@ -62,6 +170,11 @@ class RequestEncoderFixture
     }
 }
 
+class RequestEncoderMapFixture
+{
+    public Meta $meta;
+}
+
 class RequestEncoderNestedFixture
 {
     public string $innerValue = 'nested-value';
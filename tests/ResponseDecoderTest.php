@@ -8,7 +8,9 @@ use SumUp\Exception\ErrorEnvelope;
 use SumUp\Exception\UnexpectedApiException;
 use SumUp\HttpClient\Response;
 use SumUp\ResponseDecoder;
+use SumUp\Types\Customer;
 use SumUp\Types\Error;
+use SumUp\Types\PersonalDetails;
 
 class ResponseDecoderTest extends TestCase
 {
@@ -70,6 +72,20 @@ class ResponseDecoderTest extends TestCase
         $this->assertSame('ONE', $result[0]->errorCode);
     }
 
+    public function testDecodeHydratesResponseMissingRequiredFieldsOfRequestType()
+    {
+        $response = new Response(200, [
+            'personal_details' => ['first_name' => 'Alice'],
+        ]);
+
+        $customer = ResponseDecoder::decode($response, Customer::class);
+
+        $this->assertInstanceOf(Customer::class, $customer);
+        $this->assertInstanceOf(PersonalDetails::class, $customer->personalDetails);
+        $this->assertSame('Alice', $customer->personalDetails->firstName);
+        $this->assertFalse(isset($customer->customerId));
+    }
+
     public function testDecodeOrThrowDecodesOpaqueObjectDescriptorFromStdClass()
     {
         $response = new Response(200, (object) [
@@ -5,6 +5,7 @@ namespace SumUp\Tests;
 use PHPUnit\Framework\TestCase;
 use SumUp\HttpClient\RequestOptions;
 use SumUp\HttpClient\Response;
+use SumUp\Services\MembersListParams;
 use SumUp\SumUp;
 use SumUp\Tests\Doubles\FakeHttpClient;
 
@@ -132,6 +133,25 @@ class SumUpTest extends TestCase
         $this->assertSame('Bearer override-token', $requests[0]['headers']['Authorization']);
     }
 
+    public function testServiceRequestsOmitQueryParametersLeftToTheirDefault()
+    {
+        $fakeClient = new FakeHttpClient(new Response(200, ['items' => []]));
+        $sumup = new SumUp([
+            'client' => $fakeClient,
+            'access_token' => 'default-token',
+        ]);
+
+        $sumup->members()->list('MC123', new MembersListParams());
+        $params = new MembersListParams();
+        $params->limit = 50;
+        $sumup->members()->list('MC123', $params);
+
+        $requests = $fakeClient->getRequests();
+        $this->assertCount(2, $requests);
+        $this->assertSame('/v0.1/merchants/MC123/members', $requests[0]['url']);
+        $this->assertSame('/v0.1/merchants/MC123/members?limit=50', $requests[1]['url']);
+    }
+
     public function testMethodAccessUsesDefaultToken()
     {
         $sumup = new SumUp('test-key');
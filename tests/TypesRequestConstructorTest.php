@@ -52,6 +52,23 @@ class TypesRequestConstructorTest extends TestCase
         ]);
     }
 
+    public function testCheckoutCreateRequestFromArrayPassesRequiredFieldsToConstructor(): void
+    {
+        $request = CheckoutCreateRequest::fromArray([
+            'checkoutReference' => 'ref-123',
+            'amount' => '10.5',
+            'currency' => 'EUR',
+            'merchant_code' => 'MERCHANT-1',
+            'description' => 'Order 123',
+        ]);
+
+        $this->assertSame('ref-123', $request->checkoutReference);
+        $this->assertSame(10.5, $request->amount);
+        $this->assertSame(CheckoutCreateRequestCurrency::EUR, $request->currency);
+        $this->assertSame('MERCHANT-1', $request->merchantCode);
+        $this->assertSame('Order 123', $request->description);
+    }
+
     public function testCreateReaderCheckoutRequestHydratesInlineObjectProperties(): void
     {
         $request = CreateReaderCheckoutRequest::fromArray([